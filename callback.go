@@ -1,6 +1,7 @@
-//go:build (windows || linux || darwin) && (386 || amd64)
+//go:build (windows || linux || darwin) && (386 || amd64) && !nosteam
 // +build windows linux darwin
 // +build 386 amd64
+// +build !nosteam
 
 // Package internal wraps the Steamworks API.
 // from github.com/BenLubar/steamworks
@@ -14,10 +15,7 @@ package steamworks
 */
 import "C"
 import (
-	"runtime"
 	"strconv"
-	"sync"
-	"time"
 	"unsafe"
 )
 
@@ -34,61 +32,6 @@ type (
 	LobbyCreated     = C.LobbyCreated_t
 )
 
-func SteamAPI_ReleaseCurrentThreadMemory() { C.SteamAPI_ReleaseCurrentThreadMemory() }
-func SteamAPI_RunCallbacks()               { C.SteamAPI_RunCallbacks() }
-
-var (
-	callbackLock sync.Mutex
-	callbacks    = make(map[C.CallbackID_t]func(unsafe.Pointer, uintptr, bool, SteamAPICall))
-)
-
-// Cleanup should be called as follows:
-//
-//	defer internal.Cleanup()()
-//
-// It locks the current OS thread and releases Steam API thread-local memory in the returned func.
-func Cleanup() func() {
-	runtime.LockOSThread()
-
-	return func() {
-		SteamAPI_ReleaseCurrentThreadMemory()
-		runtime.UnlockOSThread()
-	}
-}
-
-//export onCallback
-func onCallback(cbid C.CallbackID_t, data unsafe.Pointer, dataLength uintptr, ioFailure bool, apiCallID SteamAPICall) {
-	callbackLock.Lock()
-	cb := callbacks[cbid]
-	callbackLock.Unlock()
-
-	if cb != nil {
-		cb(data, dataLength, ioFailure, apiCallID)
-	}
-}
-
-func registerCallback(cb func(unsafe.Pointer, uintptr, bool, SteamAPICall), size uintptr, callbackType int32, apiCallID SteamAPICall, gameServer bool) registeredCallback {
-	cbid := C.Register_Callback(C.size_t(size), C.int(callbackType), apiCallID, C.bool(gameServer))
-
-	callbackLock.Lock()
-	callbacks[cbid] = cb
-	callbackLock.Unlock()
-
-	return registeredCallback(cbid)
-}
-
-type registeredCallback C.CallbackID_t
-
-func (r registeredCallback) Unregister() {
-	cbid := C.CallbackID_t(r)
-
-	callbackLock.Lock()
-	delete(callbacks, cbid)
-	callbackLock.Unlock()
-
-	C.Unregister_Callback(cbid)
-}
-
 //export warningMessageHook
 func warningMessageHook(severity C.int, debugText *C.char) {
 	msg := C.GoString(debugText)
@@ -133,19 +76,3 @@ func GoString(str *C.char) string { return C.GoString(str) }
 
 // GoStringN wraps C.GoStringN.
 func GoStringN(str *C.char, maxSize uintptr) string { return C.GoStringN(str, C.int(maxSize)) }
-
-func runCallbacksForever() {
-	runtime.LockOSThread()
-	defer runtime.UnlockOSThread()
-
-	ticker := time.NewTicker(time.Millisecond)
-	defer ticker.Stop()
-
-	for {
-		SteamAPI_RunCallbacks()
-
-		select {
-		case <-ticker.C:
-		}
-	}
-}
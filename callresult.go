@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ESteamAPICallFailure is the reason an asynchronous call failed, as reported by
+// ISteamUtils::GetAPICallFailureReason.
+type ESteamAPICallFailure int32
+
+const (
+	ESteamAPICallFailureNone               ESteamAPICallFailure = -1 // no failure
+	ESteamAPICallFailureSteamGone          ESteamAPICallFailure = 0  // the local Steam process has gone away
+	ESteamAPICallFailureNetworkFailure     ESteamAPICallFailure = 1  // the network connection to Steam has been broken
+	ESteamAPICallFailureInvalidHandle      ESteamAPICallFailure = 2  // the SteamAPICall_t handle passed in no longer exists
+	ESteamAPICallFailureMismatchedCallback ESteamAPICallFailure = 3  // the callback type does not match the call
+)
+
+func (f ESteamAPICallFailure) String() string {
+	switch f {
+	case ESteamAPICallFailureNone:
+		return "none"
+	case ESteamAPICallFailureSteamGone:
+		return "Steam gone"
+	case ESteamAPICallFailureNetworkFailure:
+		return "network failure"
+	case ESteamAPICallFailureInvalidHandle:
+		return "invalid handle"
+	case ESteamAPICallFailureMismatchedCallback:
+		return "mismatched callback"
+	}
+	return fmt.Sprintf("ESteamAPICallFailure(%d)", int32(f))
+}
+
+// k_uAPICallInvalid is the handle returned by asynchronous calls that could not be made.
+const k_uAPICallInvalid SteamAPICallbackHandle = 0
+
+var (
+	// ErrIOFailure is reported by CallResult.Await when Steam could not deliver the result of a call.
+	ErrIOFailure = errors.New("steamworks: call result IO failure")
+
+	// ErrInvalidAPICall is reported by CallResult.Await for calls that Steam did not start.
+	ErrInvalidAPICall = errors.New("steamworks: invalid API call")
+
+	// ErrShutdown is reported by CallResult.Await for calls still pending when Shutdown
+	// is called.
+	ErrShutdown = errors.New("steamworks: Steam API shut down")
+)
+
+// CallResultError is reported by CallResult.Await when the call failed or waiting for it
+// was cancelled. Err is ErrIOFailure, ErrShutdown or the error of the context.
+type CallResultError struct {
+	Call   SteamAPICallbackHandle
+	Reason ESteamAPICallFailure
+	Err    error
+}
+
+func (e *CallResultError) Error() string {
+	msg := fmt.Sprintf("steamworks: call %d: %v", e.Call, e.Err)
+	if e.Reason != ESteamAPICallFailureNone {
+		msg += " (" + e.Reason.String() + ")"
+	}
+	return msg
+}
+
+func (e *CallResultError) Unwrap() error {
+	return e.Err
+}
+
+// CallResult is the pending result of an asynchronous Steam API call. The result is
+// delivered by RunCallbacks, so callbacks must be pumped while waiting for it.
+type CallResult[T CallbackStruct] struct {
+	call SteamAPICallbackHandle
+	done chan struct{}
+
+	once   sync.Once
+	result *T
+	err    error
+}
+
+// newCallResult returns a CallResult that waits for the result of call.
+func newCallResult[T CallbackStruct](call SteamAPICallbackHandle) *CallResult[T] {
+	r := &CallResult[T]{
+		call: call,
+		done: make(chan struct{}),
+	}
+	if call == k_uAPICallInvalid {
+		r.finish(nil, ErrInvalidAPICall)
+		return r
+	}
+
+	var zero T
+	l := callbackLayout[T]()
+	theDispatcher.handleCallResult(call, callResultHandler{
+		callbackID: zero.CallbackID(),
+		size:       int(l.size),
+		f: func(data []byte, ioFailure bool) {
+			if ioFailure {
+				r.finish(nil, &CallResultError{Call: call, Reason: callFailureReason(call), Err: ErrIOFailure})
+				return
+			}
+			r.finish(decodeCallback[T](l, data))
+		},
+		abort: func(err error) {
+			r.finish(nil, &CallResultError{Call: call, Reason: ESteamAPICallFailureNone, Err: err})
+		},
+	})
+	return r
+}
+
+// failedCallResult returns a CallResult that reports err.
+func failedCallResult[T CallbackStruct](err error) *CallResult[T] {
+	r := &CallResult[T]{done: make(chan struct{})}
+	r.finish(nil, err)
+	return r
+}
+
+func (r *CallResult[T]) finish(result *T, err error) {
+	r.once.Do(func() {
+		r.result, r.err = result, err
+		close(r.done)
+	})
+}
+
+// Call returns the handle of the asynchronous call.
+func (r *CallResult[T]) Call() SteamAPICallbackHandle {
+	return r.call
+}
+
+// Done returns a channel that is closed once the result is available or the call failed.
+func (r *CallResult[T]) Done() <-chan struct{} {
+	return r.done
+}
+
+// Await blocks until the result of the call is available and returns it.
+//
+// If ctx is done first, the call is cancelled: its result will be discarded, and Await
+// returns a *CallResultError wrapping the error of ctx with the failure reason Steam
+// reports for the call. If Shutdown is called first, Await returns a *CallResultError
+// wrapping ErrShutdown.
+func (r *CallResult[T]) Await(ctx context.Context) (*T, error) {
+	select {
+	case <-r.done:
+	case <-ctx.Done():
+		// Steam is only asked for the reason while it still knows the call. The result
+		// is kept if it was delivered meanwhile.
+		reason := ESteamAPICallFailureNone
+		if theDispatcher.cancelCallResult(r.call) {
+			reason = callFailureReason(r.call)
+		}
+		r.finish(nil, &CallResultError{Call: r.call, Reason: reason, Err: ctx.Err()})
+	}
+	return r.result, r.err
+}
+
+// abandon discards the result of the call, which nothing waits for anymore, and reports
+// err instead unless the result was delivered already.
+func (r *CallResult[T]) abandon(err error) {
+	theDispatcher.cancelCallResult(r.call)
+	r.finish(nil, &CallResultError{Call: r.call, Reason: ESteamAPICallFailureNone, Err: err})
+}
+
+// callFailureReason asks Steam why call failed.
+func callFailureReason(call SteamAPICallbackHandle) ESteamAPICallFailure {
+	u, err := SteamUtilsWithError()
+	if err != nil {
+		return ESteamAPICallFailureNone
+	}
+	reason, err := u.GetAPICallFailureReason(call)
+	if err != nil {
+		return ESteamAPICallFailureNone
+	}
+	return reason
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestCallResultAbortedByShutdown(t *testing.T) {
+	defer theDispatcher.reset()
+
+	r := newCallResult[LobbyCreated_t](1)
+	theDispatcher.reset()
+
+	select {
+	case <-r.Done():
+	default:
+		t.Fatal("call result still pending after the dispatcher was reset")
+	}
+	if _, err := r.Await(context.Background()); !errors.Is(err, ErrShutdown) {
+		t.Errorf("Await: got %v, want ErrShutdown", err)
+	}
+}
+
+func TestCallResultCancelledWithoutHandler(t *testing.T) {
+	// The handler of a call is gone once the dispatcher is reset, so cancelling finds
+	// nothing to remove.
+	r := &CallResult[LobbyCreated_t]{call: 1, done: make(chan struct{})}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := r.Await(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Await: got %v, want context.Canceled", err)
+	}
+}
+
+func TestCallResultRawAbortedByShutdown(t *testing.T) {
+	defer theDispatcher.reset()
+
+	var called, failed bool
+	HandleCallResultRaw(1, 0, 0, func(data []byte, ioFailure bool) {
+		called, failed = true, ioFailure
+	})
+	theDispatcher.reset()
+
+	if !called || !failed {
+		t.Errorf("handler called: %v, ioFailure: %v; want both true", called, failed)
+	}
+}
+
+func TestCallCompletedDropsMismatchedPayload(t *testing.T) {
+	defer theDispatcher.reset()
+	defer SetCallbackErrorHandler(nil)
+
+	var got error
+	SetCallbackErrorHandler(func(err error) {
+		got = err
+	})
+
+	r := newCallResult[LobbyCreated_t](1)
+	dropped := DroppedCallbacks()
+	theDispatcher.callCompleted(0, make([]byte, CallbackSize[SteamAPICallCompleted_t]()-1))
+
+	if n := DroppedCallbacks() - dropped; n != 1 {
+		t.Errorf("DroppedCallbacks increased by %d, want 1", n)
+	}
+	var cerr *CallbackError
+	if !errors.As(got, &cerr) || cerr.CallbackID != CallbackID_SteamAPICallCompleted_t {
+		t.Errorf("error handler got %v, want a *CallbackError for SteamAPICallCompleted_t", got)
+	}
+	select {
+	case <-r.Done():
+		t.Error("call result completed by a dropped payload")
+	default:
+	}
+}
@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"bytes"
+	"unsafe"
+)
+
+// goString copies the NUL-terminated C string at p into a Go string.
+func goString(p uintptr) string {
+	if p == 0 {
+		return ""
+	}
+	// Convert through memory so that vet does not mistake p for a Go pointer.
+	ptr := *(*unsafe.Pointer)(unsafe.Pointer(&p))
+	n := 0
+	for *(*byte)(unsafe.Add(ptr, n)) != 0 {
+		n++
+	}
+	return string(unsafe.Slice((*byte)(ptr), n))
+}
+
+// goStringBytes returns the NUL-terminated C string in b, or all of b if there is no NUL.
+func goStringBytes(b []byte) string {
+	if n := bytes.IndexByte(b, 0); n >= 0 {
+		b = b[:n]
+	}
+	return string(b)
+}
@@ -0,0 +1,279 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"reflect"
+	"sync"
+	"unsafe"
+)
+
+// The Steam API is put in manual dispatch mode once it is initialized: instead of
+// SteamAPI_RunCallbacks calling registered C++ objects, RunCallbacks fetches the pending
+// callbacks one by one and hands them to the Go handlers registered here.
+
+type callResultHandler struct {
+	callbackID int32
+	size       int
+	f          func(data []byte, ioFailure bool)
+
+	// abort is called instead of f if the call can no longer complete.
+	abort func(err error)
+}
+
+type dispatcher struct {
+	// frameMu serializes the dispatch frames. Handlers run with it held, but not mu,
+	// so that they can register and unregister handlers. A handler must not call
+	// Shutdown, which waits for the frame to end.
+	frameMu sync.Mutex
+
+	mu        sync.Mutex
+	enabled   bool
+	pipe      HSteamPipe
+	nextID    uint64
+	callbacks map[int32]map[uint64]func(data []byte)
+	results   map[SteamAPICallbackHandle]callResultHandler
+}
+
+var theDispatcher = &dispatcher{}
+
+func init() {
+	onShutdown(theDispatcher.reset)
+}
+
+// enable puts the Steam API in manual dispatch mode. It is called once the Steam API
+// is initialized.
+func (d *dispatcher) enable() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.enabled {
+		return nil
+	}
+	if err := manualDispatchInit(); err != nil {
+		return err
+	}
+	pipe, err := getHSteamPipe()
+	if err != nil {
+		return err
+	}
+	d.pipe = pipe
+	d.enabled = true
+	return nil
+}
+
+// reset disables the dispatcher and forgets all handlers. The pending call results are
+// aborted with ErrShutdown, as they will never complete.
+func (d *dispatcher) reset() {
+	d.frameMu.Lock()
+	d.mu.Lock()
+	results := d.results
+	d.enabled = false
+	d.pipe = 0
+	d.callbacks = nil
+	d.results = nil
+	d.mu.Unlock()
+	d.frameMu.Unlock()
+
+	for _, h := range results {
+		h.abort(ErrShutdown)
+	}
+}
+
+// idle runs f while no frame is being dispatched, so that neither the callback pump nor
+// the handlers call into the Steam API meanwhile.
+func (d *dispatcher) idle(f func() error) error {
+	d.frameMu.Lock()
+	defer d.frameMu.Unlock()
+	return f()
+}
+
+func (d *dispatcher) isEnabled() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.enabled
+}
+
+func (d *dispatcher) subscribe(callbackID int32, f func(data []byte)) uint64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.nextID++
+	if d.callbacks == nil {
+		d.callbacks = map[int32]map[uint64]func([]byte){}
+	}
+	if d.callbacks[callbackID] == nil {
+		d.callbacks[callbackID] = map[uint64]func([]byte){}
+	}
+	d.callbacks[callbackID][d.nextID] = f
+	return d.nextID
+}
+
+func (d *dispatcher) unsubscribe(callbackID int32, id uint64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.callbacks[callbackID], id)
+	if len(d.callbacks[callbackID]) == 0 {
+		delete(d.callbacks, callbackID)
+	}
+}
+
+func (d *dispatcher) handleCallResult(call SteamAPICallbackHandle, h callResultHandler) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.results == nil {
+		d.results = map[SteamAPICallbackHandle]callResultHandler{}
+	}
+	d.results[call] = h
+}
+
+// cancelCallResult forgets the handler for call and reports whether it was still waiting.
+func (d *dispatcher) cancelCallResult(call SteamAPICallbackHandle) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	_, ok := d.results[call]
+	delete(d.results, call)
+	return ok
+}
+
+// runFrame dispatches all pending callbacks and call results. It returns at once if a
+// frame is already running, for example if a handler calls RunCallbacks: that frame
+// dispatches the pending callbacks itself.
+func (d *dispatcher) runFrame() error {
+	if !d.frameMu.TryLock() {
+		return nil
+	}
+	defer d.frameMu.Unlock()
+
+	d.mu.Lock()
+	pipe := d.pipe
+	d.mu.Unlock()
+
+	if err := manualDispatchRunFrame(pipe); err != nil {
+		return err
+	}
+
+	for {
+		var msg CallbackMsg_t
+		ok, err := manualDispatchGetNextCallback(pipe, &msg)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		if err := d.handle(pipe, &msg); err != nil {
+			return err
+		}
+	}
+}
+
+// handle passes msg to its handlers and frees it. It is freed even if a handler panics,
+// as Steam would otherwise not deliver the next callbacks.
+func (d *dispatcher) handle(pipe HSteamPipe, msg *CallbackMsg_t) (err error) {
+	defer func() {
+		if ferr := manualDispatchFreeLastCallback(pipe); err == nil {
+			err = ferr
+		}
+	}()
+
+	data := unsafe.Slice(msg.m_pubParam, msg.m_cubParam)
+	if msg.m_iCallback == int32(k_iSteamAPICallbackCallCompleted) {
+		d.callCompleted(pipe, data)
+	} else {
+		d.dispatch(msg.m_iCallback, data)
+	}
+	return nil
+}
+
+// callCompleted decodes the SteamAPICallCompleted_t in data and completes its call. A
+// payload of the wrong size is dropped like those of Subscribe.
+func (d *dispatcher) callCompleted(pipe HSteamPipe, data []byte) {
+	completed, err := decodeCallback[SteamAPICallCompleted_t](callbackLayout[SteamAPICallCompleted_t](), data)
+	if err != nil {
+		dropCallback(&CallbackError{
+			CallbackID: CallbackID_SteamAPICallCompleted_t,
+			Type:       reflect.TypeOf(SteamAPICallCompleted_t{}).String(),
+			Err:        err,
+		})
+		return
+	}
+	d.completeCall(pipe, completed)
+}
+
+func (d *dispatcher) dispatch(callbackID int32, data []byte) {
+	d.mu.Lock()
+	fs := make([]func([]byte), 0, len(d.callbacks[callbackID]))
+	for _, f := range d.callbacks[callbackID] {
+		fs = append(fs, f)
+	}
+	d.mu.Unlock()
+
+	for _, f := range fs {
+		f(data)
+	}
+}
+
+func (d *dispatcher) completeCall(pipe HSteamPipe, completed *SteamAPICallCompleted_t) {
+	d.mu.Lock()
+	h, ok := d.results[completed.m_hAsyncCall]
+	delete(d.results, completed.m_hAsyncCall)
+	d.mu.Unlock()
+
+	if !ok {
+		return
+	}
+
+	data := make([]byte, h.size)
+	ok, failed, err := manualDispatchGetAPICallResult(pipe, completed.m_hAsyncCall, data, h.callbackID)
+	if err != nil || !ok {
+		h.f(nil, true)
+		return
+	}
+	h.f(data, failed)
+}
+
+// Subscription identifies a handler registered for a global callback.
+type Subscription struct {
+	callbackID int32
+	id         uint64
+}
+
+// SubscribeRaw registers f to be called with the payload of every callback with the
+// given callback ID, the k_iCallback value of its struct. f runs during RunCallbacks
+// and must not retain data, which is only valid until it returns.
+func SubscribeRaw(callbackID int32, f func(data []byte)) Subscription {
+	return Subscription{
+		callbackID: callbackID,
+		id:         theDispatcher.subscribe(callbackID, f),
+	}
+}
+
+// ID returns the identifier of the subscription, unique within the process.
+func (s Subscription) ID() uint64 {
+	return s.id
+}
+
+// Unsubscribe stops calling the handler of s.
+func (s Subscription) Unsubscribe() {
+	theDispatcher.unsubscribe(s.callbackID, s.id)
+}
+
+// HandleCallResultRaw registers f to be called once the asynchronous call completes.
+// callbackID and size are the k_iCallback value and size of the struct the call returns.
+// data is nil if the result could not be retrieved. f runs during RunCallbacks, or
+// during Shutdown with ioFailure set if the call is still pending then.
+func HandleCallResultRaw(call SteamAPICallbackHandle, callbackID int32, size int, f func(data []byte, ioFailure bool)) {
+	theDispatcher.handleCallResult(call, callResultHandler{
+		callbackID: callbackID,
+		size:       size,
+		f:          f,
+		abort: func(error) {
+			f(nil, true)
+		},
+	})
+}
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+// DLC is a downloadable content of the running app.
+type DLC struct {
+	AppID     AppId_t
+	Name      string
+	Available bool // whether the DLC is on the store
+	Installed bool
+
+	// BytesDownloaded and BytesTotal are the progress of the download of the DLC while
+	// Steam downloads it, and 0 otherwise.
+	BytesDownloaded uint64
+	BytesTotal      uint64
+}
+
+// Downloading reports whether Steam is downloading the DLC.
+func (d *DLC) Downloading() bool {
+	return d.BytesTotal > 0
+}
+
+// Progress returns the downloaded fraction of the DLC, from 0 to 1. It is 1 for an
+// installed DLC that is not being downloaded.
+func (d *DLC) Progress() float64 {
+	if d.BytesTotal == 0 {
+		if d.Installed {
+			return 1
+		}
+		return 0
+	}
+	return float64(d.BytesDownloaded) / float64(d.BytesTotal)
+}
+
+// Refresh updates whether the DLC is installed and the progress of its download.
+func (d *DLC) Refresh(apps ISteamApps) {
+	d.Installed = apps.BIsDlcInstalled(d.AppID)
+	d.BytesDownloaded, d.BytesTotal, _ = apps.GetDlcDownloadProgress(d.AppID)
+}
+
+// ListDLC returns the DLC of the running app with their installation state and
+// download progress. Call Refresh on an element to update it, for example every frame
+// while it is downloading.
+func ListDLC(apps ISteamApps) []DLC {
+	n := apps.GetDLCCount()
+	if n <= 0 {
+		return nil
+	}
+	dlcs := make([]DLC, 0, n)
+	for i := int32(0); i < n; i++ {
+		appID, available, name, ok := apps.BGetDLCDataByIndex(i)
+		if !ok {
+			continue
+		}
+		d := DLC{
+			AppID:     appID,
+			Name:      name,
+			Available: available,
+		}
+		d.Refresh(apps)
+		dlcs = append(dlcs, d)
+	}
+	return dlcs
+}
+
+// SubscribeDLCInstalled registers f to be called with the app ID of every DLC that
+// finishes installing, as reported by DlcInstalled_t. f runs during RunCallbacks.
+func SubscribeDLCInstalled(f func(appID AppId_t)) Subscription {
+	return Subscribe(func(e *DlcInstalled_t) {
+		f(e.AppID)
+	})
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+//go:build !nosteam
+
+package steamworks
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// #cgo LDFLAGS: -ldl
+//
+// #include <stdint.h>
+// #include <stdlib.h>
+// #include <dlfcn.h>
+//
+// static uintptr_t dlsym_(uintptr_t handle, const char* name) {
+//   return (uintptr_t)dlsym((void*)handle, name);
+// }
+import "C"
+
+// This backend calls into the library through the cgo trampolines of trampolines.gen.go,
+// one per function type, on platforms the cgo-free backend does not support.
+
+func dlopen(path string) (uintptr, error) {
+	cpath := C.CString(path)
+	defer C.free(unsafe.Pointer(cpath))
+
+	lib := uintptr(C.dlopen(cpath, C.RTLD_LAZY))
+	if lib == 0 {
+		return 0, fmt.Errorf("steamworks: dlopen failed: %s", C.GoString(C.dlerror()))
+	}
+
+	return lib, nil
+}
+
+func dlclose(lib uintptr) error {
+	if C.dlclose(unsafe.Pointer(lib)) != 0 {
+		return fmt.Errorf("steamworks: dlclose failed: %s", C.GoString(C.dlerror()))
+	}
+	return nil
+}
+
+func dlsym(lib uintptr, name string) uintptr {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	return uintptr(C.dlsym_(C.uintptr_t(lib), cname))
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+//go:build ((linux && amd64) || darwin) && !nosteam
+
+package steamworks
+
+import (
+	"fmt"
+
+	"github.com/ebitengine/purego"
+)
+
+// This backend loads and calls into the library without cgo. purego's assembly
+// trampolines pass any list of integer and pointer arguments in registers and on the
+// stack as the C calling convention requires, so the function type is not needed.
+
+func dlopen(path string) (uintptr, error) {
+	lib, err := purego.Dlopen(path, purego.RTLD_LAZY)
+	if err != nil {
+		return 0, fmt.Errorf("steamworks: dlopen failed: %w", err)
+	}
+	return lib, nil
+}
+
+func dlclose(lib uintptr) error {
+	if err := purego.Dlclose(lib); err != nil {
+		return fmt.Errorf("steamworks: dlclose failed: %w", err)
+	}
+	return nil
+}
+
+func dlsym(lib uintptr, name string) uintptr {
+	f, err := purego.Dlsym(lib, name)
+	if err != nil {
+		return 0
+	}
+	return f
+}
+
+//go:uintptrescapes
+func callProc(ftype funcType, f uintptr, args ...uintptr) (uint64, error) {
+	r, _, _ := purego.SyscallN(f, args...)
+	return uint64(r), nil
+}
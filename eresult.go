@@ -0,0 +1,265 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"errors"
+	"fmt"
+)
+
+// EResult implements error, so that a result other than EResultOK can be returned
+// and checked like any other error:
+//
+//	if err := steamworks.SteamNetworkingMessages().SendMessageToUser(id, data, 0, 0).Err(); err != nil {
+//		if errors.Is(err, steamworks.ErrRetryable) {
+//			// Try again later.
+//		}
+//		return err
+//	}
+
+var (
+	// ErrRetryable matches the results for which IsRetryable reports true.
+	ErrRetryable = errors.New("steamworks: retryable result")
+
+	// ErrAuthFailure matches the results for which IsAuthFailure reports true.
+	ErrAuthFailure = errors.New("steamworks: authentication failure")
+
+	// ErrRateLimited matches the results for which IsRateLimited reports true.
+	ErrRateLimited = errors.New("steamworks: rate limited")
+)
+
+func (r EResult) String() string {
+	if name, ok := eResultNames[r]; ok {
+		return name
+	}
+	return fmt.Sprintf("EResult(%d)", int32(r))
+}
+
+func (r EResult) Error() string {
+	return fmt.Sprintf("steamworks: result %s (%d)", r.String(), int32(r))
+}
+
+// Err returns nil if r is EResultOK, and r otherwise.
+func (r EResult) Err() error {
+	if r == EResultOK {
+		return nil
+	}
+	return r
+}
+
+// Is reports whether r belongs to the class of results target stands for, one of
+// ErrRetryable, ErrAuthFailure and ErrRateLimited.
+func (r EResult) Is(target error) bool {
+	switch target {
+	case ErrRetryable:
+		return r.IsRetryable()
+	case ErrAuthFailure:
+		return r.IsAuthFailure()
+	case ErrRateLimited:
+		return r.IsRateLimited()
+	}
+	return false
+}
+
+// IsRetryable reports whether the operation failed for a transient reason, such as a
+// timeout, a busy or unavailable service or a rate limit, and may succeed if tried again later.
+func (r EResult) IsRetryable() bool {
+	switch r {
+	case EResultNoConnection,
+		EResultBusy,
+		EResultTimeout,
+		EResultServiceUnavailable,
+		EResultPending,
+		EResultLockingFailed,
+		EResultConnectFailed,
+		EResultIOFailure,
+		EResultRemoteDisconnect,
+		EResultServiceReadOnly,
+		EResultTryAnotherCM,
+		EResultRemoteCallFailed:
+		return true
+	}
+	return r.IsRateLimited()
+}
+
+// IsAuthFailure reports whether the operation failed because the user could not be
+// authenticated or is not allowed to perform it.
+func (r EResult) IsAuthFailure() bool {
+	switch r {
+	case EResultInvalidPassword,
+		EResultLoggedInElsewhere,
+		EResultAccessDenied,
+		EResultNotLoggedOn,
+		EResultInsufficientPrivilege,
+		EResultRevoked,
+		EResultExpired,
+		EResultLogonSessionReplaced,
+		EResultPasswordRequiredToKickSession,
+		EResultAlreadyLoggedInElsewhere,
+		EResultPSNTicketInvalid,
+		EResultAccountLogonDenied,
+		EResultInvalidLoginAuthCode,
+		EResultAccountLogonDeniedNoMail,
+		EResultExpiredLoginAuthCode,
+		EResultIPLoginRestrictionFailed,
+		EResultAccountLogonDeniedVerifiedEmailRequired,
+		EResultRequirePasswordReEntry,
+		EResultAccountLoginDeniedNeedTwoFactor,
+		EResultAccountLoginDeniedThrottle,
+		EResultTwoFactorCodeMismatch,
+		EResultGSLTDenied,
+		EResultGSLTExpired,
+		EResultInvalidSignature,
+		EResultCachedCredentialInvalid:
+		return true
+	}
+	return false
+}
+
+// IsRateLimited reports whether the operation was refused because too many requests
+// or changes were made recently.
+func (r EResult) IsRateLimited() bool {
+	switch r {
+	case EResultLimitExceeded,
+		EResultRateLimitExceeded,
+		EResultAccountLoginDeniedThrottle,
+		EResultAccountLimitExceeded,
+		EResultAccountActivityLimitExceeded,
+		EResultPhoneActivityLimitExceeded,
+		EResultTooManyPending,
+		EResultCommunityCooldown:
+		return true
+	}
+	return false
+}
+
+var eResultNames = map[EResult]string{
+	EResultNone:                                    "None",
+	EResultOK:                                      "OK",
+	EResultFail:                                    "Fail",
+	EResultNoConnection:                            "NoConnection",
+	EResultInvalidPassword:                         "InvalidPassword",
+	EResultLoggedInElsewhere:                       "LoggedInElsewhere",
+	EResultInvalidProtocolVer:                      "InvalidProtocolVer",
+	EResultInvalidParam:                            "InvalidParam",
+	EResultFileNotFound:                            "FileNotFound",
+	EResultBusy:                                    "Busy",
+	EResultInvalidState:                            "InvalidState",
+	EResultInvalidName:                             "InvalidName",
+	EResultInvalidEmail:                            "InvalidEmail",
+	EResultDuplicateName:                           "DuplicateName",
+	EResultAccessDenied:                            "AccessDenied",
+	EResultTimeout:                                 "Timeout",
+	EResultBanned:                                  "Banned",
+	EResultAccountNotFound:                         "AccountNotFound",
+	EResultInvalidSteamID:                          "InvalidSteamID",
+	EResultServiceUnavailable:                      "ServiceUnavailable",
+	EResultNotLoggedOn:                             "NotLoggedOn",
+	EResultPending:                                 "Pending",
+	EResultEncryptionFailure:                       "EncryptionFailure",
+	EResultInsufficientPrivilege:                   "InsufficientPrivilege",
+	EResultLimitExceeded:                           "LimitExceeded",
+	EResultRevoked:                                 "Revoked",
+	EResultExpired:                                 "Expired",
+	EResultAlreadyRedeemed:                         "AlreadyRedeemed",
+	EResultDuplicateRequest:                        "DuplicateRequest",
+	EResultAlreadyOwned:                            "AlreadyOwned",
+	EResultIPNotFound:                              "IPNotFound",
+	EResultPersistFailed:                           "PersistFailed",
+	EResultLockingFailed:                           "LockingFailed",
+	EResultLogonSessionReplaced:                    "LogonSessionReplaced",
+	EResultConnectFailed:                           "ConnectFailed",
+	EResultHandshakeFailed:                         "HandshakeFailed",
+	EResultIOFailure:                               "IOFailure",
+	EResultRemoteDisconnect:                        "RemoteDisconnect",
+	EResultShoppingCartNotFound:                    "ShoppingCartNotFound",
+	EResultBlocked:                                 "Blocked",
+	EResultIgnored:                                 "Ignored",
+	EResultNoMatch:                                 "NoMatch",
+	EResultAccountDisabled:                         "AccountDisabled",
+	EResultServiceReadOnly:                         "ServiceReadOnly",
+	EResultAccountNotFeatured:                      "AccountNotFeatured",
+	EResultAdministratorOK:                         "AdministratorOK",
+	EResultContentVersion:                          "ContentVersion",
+	EResultTryAnotherCM:                            "TryAnotherCM",
+	EResultPasswordRequiredToKickSession:           "PasswordRequiredToKickSession",
+	EResultAlreadyLoggedInElsewhere:                "AlreadyLoggedInElsewhere",
+	EResultSuspended:                               "Suspended",
+	EResultCancelled:                               "Cancelled",
+	EResultDataCorruption:                          "DataCorruption",
+	EResultDiskFull:                                "DiskFull",
+	EResultRemoteCallFailed:                        "RemoteCallFailed",
+	EResultPasswordUnset:                           "PasswordUnset",
+	EResultExternalAccountUnlinked:                 "ExternalAccountUnlinked",
+	EResultPSNTicketInvalid:                        "PSNTicketInvalid",
+	EResultExternalAccountAlreadyLinked:            "ExternalAccountAlreadyLinked",
+	EResultRemoteFileConflict:                      "RemoteFileConflict",
+	EResultIllegalPassword:                         "IllegalPassword",
+	EResultSameAsPreviousValue:                     "SameAsPreviousValue",
+	EResultAccountLogonDenied:                      "AccountLogonDenied",
+	EResultCannotUseOldPassword:                    "CannotUseOldPassword",
+	EResultInvalidLoginAuthCode:                    "InvalidLoginAuthCode",
+	EResultAccountLogonDeniedNoMail:                "AccountLogonDeniedNoMail",
+	EResultHardwareNotCapableOfIPT:                 "HardwareNotCapableOfIPT",
+	EResultIPTInitError:                            "IPTInitError",
+	EResultParentalControlRestricted:               "ParentalControlRestricted",
+	EResultFacebookQueryError:                      "FacebookQueryError",
+	EResultExpiredLoginAuthCode:                    "ExpiredLoginAuthCode",
+	EResultIPLoginRestrictionFailed:                "IPLoginRestrictionFailed",
+	EResultAccountLockedDown:                       "AccountLockedDown",
+	EResultAccountLogonDeniedVerifiedEmailRequired: "AccountLogonDeniedVerifiedEmailRequired",
+	EResultNoMatchingURL:                           "NoMatchingURL",
+	EResultBadResponse:                             "BadResponse",
+	EResultRequirePasswordReEntry:                  "RequirePasswordReEntry",
+	EResultValueOutOfRange:                         "ValueOutOfRange",
+	EResultUnexpectedError:                         "UnexpectedError",
+	EResultDisabled:                                "Disabled",
+	EResultInvalidCEGSubmission:                    "InvalidCEGSubmission",
+	EResultRestrictedDevice:                        "RestrictedDevice",
+	EResultRegionLocked:                            "RegionLocked",
+	EResultRateLimitExceeded:                       "RateLimitExceeded",
+	EResultAccountLoginDeniedNeedTwoFactor:         "AccountLoginDeniedNeedTwoFactor",
+	EResultItemDeleted:                             "ItemDeleted",
+	EResultAccountLoginDeniedThrottle:              "AccountLoginDeniedThrottle",
+	EResultTwoFactorCodeMismatch:                   "TwoFactorCodeMismatch",
+	EResultTwoFactorActivationCodeMismatch:         "TwoFactorActivationCodeMismatch",
+	EResultAccountAssociatedToMultiplePartners:     "AccountAssociatedToMultiplePartners",
+	EResultNotModified:                             "NotModified",
+	EResultNoMobileDevice:                          "NoMobileDevice",
+	EResultTimeNotSynced:                           "TimeNotSynced",
+	EResultSmsCodeFailed:                           "SmsCodeFailed",
+	EResultAccountLimitExceeded:                    "AccountLimitExceeded",
+	EResultAccountActivityLimitExceeded:            "AccountActivityLimitExceeded",
+	EResultPhoneActivityLimitExceeded:              "PhoneActivityLimitExceeded",
+	EResultRefundToWallet:                          "RefundToWallet",
+	EResultEmailSendFailure:                        "EmailSendFailure",
+	EResultNotSettled:                              "NotSettled",
+	EResultNeedCaptcha:                             "NeedCaptcha",
+	EResultGSLTDenied:                              "GSLTDenied",
+	EResultGSOwnerDenied:                           "GSOwnerDenied",
+	EResultInvalidItemType:                         "InvalidItemType",
+	EResultIPBanned:                                "IPBanned",
+	EResultGSLTExpired:                             "GSLTExpired",
+	EResultInsufficientFunds:                       "InsufficientFunds",
+	EResultTooManyPending:                          "TooManyPending",
+	EResultNoSiteLicensesFound:                     "NoSiteLicensesFound",
+	EResultWGNetworkSendExceeded:                   "WGNetworkSendExceeded",
+	EResultAccountNotFriends:                       "AccountNotFriends",
+	EResultLimitedUserAccount:                      "LimitedUserAccount",
+	EResultCantRemoveItem:                          "CantRemoveItem",
+	EResultAccountDeleted:                          "AccountDeleted",
+	EResultExistingUserCancelledLicense:            "ExistingUserCancelledLicense",
+	EResultCommunityCooldown:                       "CommunityCooldown",
+	EResultNoLauncherSpecified:                     "NoLauncherSpecified",
+	EResultMustAgreeToSSA:                          "MustAgreeToSSA",
+	EResultLauncherMigrated:                        "LauncherMigrated",
+	EResultSteamRealmMismatch:                      "SteamRealmMismatch",
+	EResultInvalidSignature:                        "InvalidSignature",
+	EResultParseFailure:                            "ParseFailure",
+	EResultNoVerifiedPhone:                         "NoVerifiedPhone",
+	EResultInsufficientBattery:                     "InsufficientBattery",
+	EResultChargerRequired:                         "ChargerRequired",
+	EResultCachedCredentialInvalid:                 "CachedCredentialInvalid",
+	EResultPhoneNumberIsVOIP:                       "PhoneNumberIsVOIP",
+}
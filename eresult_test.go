@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestEResultString(t *testing.T) {
+	tests := []struct {
+		r    EResult
+		want string
+	}{
+		{EResultOK, "OK"},
+		{EResultFail, "Fail"},
+		{EResultAccessDenied, "AccessDenied"},
+		{EResultCommunityCooldown, "CommunityCooldown"},
+		{EResult(-1), "EResult(-1)"},
+		{EResult(100000), "EResult(100000)"},
+	}
+	for _, tt := range tests {
+		if got := tt.r.String(); got != tt.want {
+			t.Errorf("EResult(%d).String() = %q, want %q", int32(tt.r), got, tt.want)
+		}
+	}
+
+	if got, want := EResultBusy.Error(), "steamworks: result Busy (10)"; got != want {
+		t.Errorf("EResultBusy.Error() = %q, want %q", got, want)
+	}
+}
+
+func TestEResultErr(t *testing.T) {
+	if err := EResultOK.Err(); err != nil {
+		t.Errorf("EResultOK.Err() = %v, want nil", err)
+	}
+	if err := EResultFail.Err(); err != EResultFail {
+		t.Errorf("EResultFail.Err() = %v, want EResultFail", err)
+	}
+}
+
+func TestEResultClasses(t *testing.T) {
+	tests := []struct {
+		r                               EResult
+		retryable, authFailure, limited bool
+	}{
+		{EResultOK, false, false, false},
+		{EResultFail, false, false, false},
+		{EResultInvalidParam, false, false, false},
+		{EResultTimeout, true, false, false},
+		{EResultBusy, true, false, false},
+		{EResultServiceUnavailable, true, false, false},
+		{EResultRemoteCallFailed, true, false, false},
+		{EResultAccessDenied, false, true, false},
+		{EResultNotLoggedOn, false, true, false},
+		{EResultCachedCredentialInvalid, false, true, false},
+		{EResultRateLimitExceeded, true, false, true},
+		{EResultCommunityCooldown, true, false, true},
+		{EResultAccountLoginDeniedThrottle, true, true, true},
+	}
+	for _, tt := range tests {
+		if got := tt.r.IsRetryable(); got != tt.retryable {
+			t.Errorf("%v.IsRetryable() = %v, want %v", tt.r, got, tt.retryable)
+		}
+		if got := tt.r.IsAuthFailure(); got != tt.authFailure {
+			t.Errorf("%v.IsAuthFailure() = %v, want %v", tt.r, got, tt.authFailure)
+		}
+		if got := tt.r.IsRateLimited(); got != tt.limited {
+			t.Errorf("%v.IsRateLimited() = %v, want %v", tt.r, got, tt.limited)
+		}
+
+		// errors.Is must agree with the helpers, also through a wrapped error.
+		err := fmt.Errorf("send: %w", tt.r)
+		if got := errors.Is(err, ErrRetryable); got != tt.retryable {
+			t.Errorf("errors.Is(%v, ErrRetryable) = %v, want %v", tt.r, got, tt.retryable)
+		}
+		if got := errors.Is(err, ErrAuthFailure); got != tt.authFailure {
+			t.Errorf("errors.Is(%v, ErrAuthFailure) = %v, want %v", tt.r, got, tt.authFailure)
+		}
+		if got := errors.Is(err, ErrRateLimited); got != tt.limited {
+			t.Errorf("errors.Is(%v, ErrRateLimited) = %v, want %v", tt.r, got, tt.limited)
+		}
+	}
+}
+
+func TestEResultIsSameResult(t *testing.T) {
+	err := fmt.Errorf("send: %w", EResultTimeout)
+	if !errors.Is(err, EResultTimeout) {
+		t.Error("errors.Is does not match the wrapped result")
+	}
+	if errors.Is(err, EResultBusy) {
+		t.Error("errors.Is matches another result")
+	}
+	if errors.Is(EResultTimeout, errors.New("other")) {
+		t.Error("EResult matches an unrelated error")
+	}
+}
@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// ErrInterfaceNotAvailable is reported when a Steam interface accessor returns NULL,
+// typically because the Steam API is not initialized.
+var ErrInterfaceNotAvailable = errors.New("steamworks: interface not available")
+
+// ErrNoSteam is reported by builds with the nosteam tag, in which the Steam API is never available.
+var ErrNoSteam = errors.New("steamworks: Steam is not available in this build")
+
+// ErrUnsupportedVersion is reported when calling a method that the bound version of its
+// interface does not provide. See InterfaceVersion.
+var ErrUnsupportedVersion = errors.New("steamworks: method not supported by the interface version")
+
+// ErrLibraryInUse is returned by Shutdown when calls into the Steam API are still in
+// progress on other goroutines. Shutdown then leaves the Steam API running and loaded.
+var ErrLibraryInUse = errors.New("steamworks: Steam API library in use by calls in progress")
+
+// InitFailure classifies why the Steam API could not be initialized.
+type InitFailure int
+
+const (
+	// InitFailureGeneric is reported when Steam gave no more specific reason.
+	InitFailureGeneric InitFailure = iota
+
+	// InitFailureLibraryNotLoaded is reported when the Steam API library could not be loaded.
+	InitFailureLibraryNotLoaded
+
+	// InitFailureSymbolNotFound is reported when the loaded Steam API library lacks an initialization entry point.
+	InitFailureSymbolNotFound
+
+	// InitFailureNoSteamClient is reported when the Steam client is not running.
+	InitFailureNoSteamClient
+
+	// InitFailureNoAppID is reported when no app ID could be determined for the process.
+	InitFailureNoAppID
+
+	// InitFailureVersionMismatch is reported when the Steam client is older than the Steam API library.
+	InitFailureVersionMismatch
+)
+
+func (f InitFailure) String() string {
+	switch f {
+	case InitFailureGeneric:
+		return "generic failure"
+	case InitFailureLibraryNotLoaded:
+		return "Steam API library not loaded"
+	case InitFailureSymbolNotFound:
+		return "symbol not found"
+	case InitFailureNoSteamClient:
+		return "Steam client not running"
+	case InitFailureNoAppID:
+		return "no app ID"
+	case InitFailureVersionMismatch:
+		return "Steam client out of date"
+	}
+	return "unknown failure"
+}
+
+// InitError is the error returned by InitWithError.
+type InitError struct {
+	// Failure is the reason the initialization failed.
+	Failure InitFailure
+
+	// Message is the message reported by Steam, if any.
+	Message string
+
+	// Err is the underlying error, if any.
+	Err error
+}
+
+func (e *InitError) Error() string {
+	msg := "steamworks: initialization failed: " + e.Failure.String()
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return msg
+}
+
+func (e *InitError) Unwrap() error {
+	return e.Err
+}
+
+// symbolError reports a flat API symbol that is missing from the loaded library.
+type symbolError struct {
+	name string
+}
+
+func (e *symbolError) Error() string {
+	return "steamworks: symbol " + e.name + " not found"
+}
+
+// versionError reports a method that the bound version of its interface does not provide.
+type versionError struct {
+	method  string
+	iface   string
+	version int
+}
+
+func (e *versionError) Error() string {
+	return fmt.Sprintf("steamworks: %s is not supported by %s v%03d", e.method, e.iface, e.version)
+}
+
+func (e *versionError) Is(target error) bool {
+	return target == ErrUnsupportedVersion
+}
+
+// interfaceError reports that the interface accessor name returned NULL.
+func interfaceError(name string) error {
+	return fmt.Errorf("%w: %s returned NULL", ErrInterfaceNotAvailable, name)
+}
+
+// panicError converts a value recovered from a panic into an error.
+func panicError(r interface{}) error {
+	if err, ok := r.(error); ok {
+		return err
+	}
+	return fmt.Errorf("steamworks: %v", r)
+}
+
+// initCallError converts an error from calling an initialization entry point into an *InitError.
+func initCallError(err error) error {
+	var serr *symbolError
+	if errors.As(err, &serr) {
+		return &InitError{Failure: InitFailureSymbolNotFound, Err: err}
+	}
+	return &InitError{Failure: InitFailureGeneric, Err: err}
+}
+
+// initFlatError converts the result of SteamAPI_InitFlat into an error.
+func initFlatError(result ESteamAPIInitResult, msg []byte) error {
+	if i := bytes.IndexByte(msg, 0); i >= 0 {
+		msg = msg[:i]
+	}
+	e := &InitError{Message: string(msg)}
+	switch result {
+	case ESteamAPIInitResult_OK:
+		return nil
+	case ESteamAPIInitResult_NoSteamClient:
+		e.Failure = InitFailureNoSteamClient
+	case ESteamAPIInitResult_VersionMismatch:
+		e.Failure = InitFailureVersionMismatch
+	default:
+		if !hasAppID() {
+			e.Failure = InitFailureNoAppID
+		}
+	}
+	return e
+}
+
+// initBoolError builds the error for a failed SteamAPI_Init, which does not report a reason itself.
+func initBoolError(steamRunning bool) error {
+	if !steamRunning {
+		return &InitError{Failure: InitFailureNoSteamClient}
+	}
+	if !hasAppID() {
+		return &InitError{Failure: InitFailureNoAppID}
+	}
+	return &InitError{Failure: InitFailureGeneric}
+}
+
+// hasAppID reports whether Steam can find an app ID for this process, either from the
+// environment set by the Steam client or from a steam_appid.txt file.
+func hasAppID() bool {
+	if os.Getenv("SteamAppId") != "" {
+		return true
+	}
+	if _, err := os.Stat("steam_appid.txt"); err == nil {
+		return true
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		return false
+	}
+	if _, err := os.Stat(filepath.Join(filepath.Dir(exe), "steam_appid.txt")); err == nil {
+		return true
+	}
+	return false
+}
@@ -3,57 +3,120 @@
 
 //go:build ignore
 
+// gen extracts the redistributable libraries from the Steamworks SDK and generates the
+// bindings of the functions listed in bound from the SDK's steam_api.json:
+//
+//   - flatapi.gen.go: the flatAPI identifiers and symbol names, the enums and typedefs
+//     the bound functions use, and the interfaces that are not defined by hand
+//   - flatcalls.gen.go: a typed call stub for every bound function, shared by all
+//     platforms
+//   - trampolines.gen.go: the cgo trampolines of the function types for Linux (386)
+//   - nosteam.gen.go: the implementations of the generated interfaces for the nosteam tag,
+//     if any
+//
+// Types defined by hand elsewhere in the package take precedence over the generated ones.
+// The interfaces of the package are written by hand, as their methods return Go values
+// such as strings, slices and errors rather than the C results, so the generated
+// interfaces only cover bound classes that have none. The callback structs are generated
+// by gencallbacks.go, from api.gen.h rather than steam_api.json, as their layouts must be
+// measured. Generating the whole of steam_api.json, rather than the functions listed in
+// bound, is not supported.
 package main
 
 import (
 	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
 	"io"
 	"os"
+	"sort"
+	"strings"
 )
 
-const version = "155"
+var version = flag.String("version", "155", "version of the Steamworks SDK, such as 155 for steamworks_sdk_155.zip")
+
+// generated are the files written by this generator.
+var generated = map[string]bool{
+	"flatapi.gen.go":     true,
+	"flatcalls.gen.go":   true,
+	"trampolines.gen.go": true,
+	"nosteam.gen.go":     true,
+}
 
 func main() {
+	flag.Parse()
 	if err := run(); err != nil {
 		panic(err)
 	}
 }
 
 func run() error {
-	dir, err := os.MkdirTemp("", "go-steamworks")
+	zipfile, err := os.Open(fmt.Sprintf("steamworks_sdk_%s.zip", *version))
 	if err != nil {
+		if os.IsNotExist(err) {
+			sdkURL := "https://partner.steamgames.com/downloads/steamworks_sdk_" + *version + ".zip"
+			return fmt.Errorf("steamworks_sdk_%s.zip must exist; download it from %s with your Steamworks account", *version, sdkURL)
+		}
 		return err
 	}
-	defer os.RemoveAll(dir)
+	defer zipfile.Close()
 
-	if err := processZip(dir); err != nil {
+	stat, err := zipfile.Stat()
+	if err != nil {
+		return err
+	}
+	r, err := zip.NewReader(zipfile, stat.Size())
+	if err != nil {
 		return err
 	}
 
-	return nil
-}
+	if err := extractLibs(r); err != nil {
+		return err
+	}
 
-func processZip(dir string) error {
-	zipfile, err := os.Open(fmt.Sprintf("steamworks_sdk_%s.zip", version))
+	api, err := readAPI(r)
 	if err != nil {
-		if os.IsNotExist(err) {
-			const sdkURL = "https://partner.steamgames.com/downloads/steamworks_sdk_" + version + ".zip"
-			return fmt.Errorf("steamworks_sdk.zip must exist; download it from %s with your Steamworks account", sdkURL)
-		}
 		return err
 	}
-	defer zipfile.Close()
-
-	stat, err := zipfile.Stat()
+	declared, err := declaredTypes(".")
 	if err != nil {
 		return err
 	}
-	r, err := zip.NewReader(zipfile, stat.Size())
+	g, err := newGenerator(api, declared)
 	if err != nil {
 		return err
 	}
+	for name, f := range map[string]func() []byte{
+		"flatapi.gen.go":     g.flatAPI,
+		"flatcalls.gen.go":   g.flatCalls,
+		"trampolines.gen.go": g.trampolines,
+		"nosteam.gen.go":     g.noSteam,
+	} {
+		b := f()
+		if b == nil {
+			if err := os.Remove(name); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			continue
+		}
+		src, err := format.Source(b)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		if err := os.WriteFile(name, src, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
+func extractLibs(r *zip.Reader) error {
 	for path, filename := range map[string]string{
 		"sdk/redistributable_bin/linux32/libsteam_api.so": "libsteam_api.so",
 		"sdk/redistributable_bin/linux64/libsteam_api.so": "libsteam_api64.so",
@@ -61,22 +124,949 @@ func processZip(dir string) error {
 		"sdk/redistributable_bin/steam_api.dll":           "steam_api.dll",
 		"sdk/redistributable_bin/win64/steam_api64.dll":   "steam_api64.dll",
 	} {
-		f, err := r.Open(path)
-		if err != nil {
+		if err := extractFile(r, path, filename); err != nil {
 			return err
 		}
-		defer f.Close()
+	}
+	return nil
+}
 
-		var out io.Writer
-		out, err = os.Create(filename)
+// extractFile copies the file at path in r to filename.
+func extractFile(r *zip.Reader, path, filename string) error {
+	f, err := r.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	out, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, f); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// The schema of steam_api.json.
+
+type steamAPI struct {
+	Enums      []apiEnum      `json:"enums"`
+	Interfaces []apiInterface `json:"interfaces"`
+	Structs    []apiStruct    `json:"structs"`
+	Typedefs   []apiTypedef   `json:"typedefs"`
+}
+
+type apiEnum struct {
+	Name   string `json:"enumname"`
+	Values []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	} `json:"values"`
+}
+
+type apiInterface struct {
+	Name      string `json:"classname"`
+	Accessors []struct {
+		Kind     string `json:"kind"`
+		Name     string `json:"name"`
+		NameFlat string `json:"name_flat"`
+	} `json:"accessors"`
+	Methods []apiMethod `json:"methods"`
+}
+
+type apiMethod struct {
+	Name       string     `json:"methodname"`
+	NameFlat   string     `json:"methodname_flat"`
+	Params     []apiParam `json:"params"`
+	ReturnType string     `json:"returntype"`
+}
+
+type apiParam struct {
+	Name string `json:"paramname"`
+	Type string `json:"paramtype"`
+}
+
+type apiStruct struct {
+	Name   string `json:"struct"`
+	Fields []struct {
+		Name string `json:"fieldname"`
+		Type string `json:"fieldtype"`
+	} `json:"fields"`
+	Methods []apiMethod `json:"methods"`
+}
+
+type apiTypedef struct {
+	Name string `json:"typedef"`
+	Type string `json:"type"`
+}
+
+func readAPI(r *zip.Reader) (*steamAPI, error) {
+	f, err := r.Open("sdk/public/steam/steam_api.json")
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var api steamAPI
+	if err := json.NewDecoder(f).Decode(&api); err != nil {
+		return nil, fmt.Errorf("steam_api.json: %w", err)
+	}
+	return &api, nil
+}
+
+// globals are the functions of steam_api_flat.h that steam_api.json does not describe.
+var globals = []apiMethod{
+	{NameFlat: "SteamAPI_RestartAppIfNecessary", ReturnType: "bool", Params: []apiParam{{"unOwnAppID", "uint32"}}},
+	{NameFlat: "SteamAPI_Init", ReturnType: "bool"},
+	{NameFlat: "SteamAPI_InitFlat", ReturnType: "ESteamAPIInitResult", Params: []apiParam{{"pOutErrMsg", "SteamErrMsg *"}}},
+	{NameFlat: "SteamAPI_IsSteamRunning", ReturnType: "bool"},
+	{NameFlat: "SteamAPI_RunCallbacks", ReturnType: "void"},
+	{NameFlat: "SteamAPI_Shutdown", ReturnType: "void"},
+	{NameFlat: "SteamAPI_ReleaseCurrentThreadMemory", ReturnType: "void"},
+	{NameFlat: "SteamAPI_GetHSteamPipe", ReturnType: "HSteamPipe"},
+	{NameFlat: "SteamAPI_ManualDispatch_Init", ReturnType: "void"},
+	{NameFlat: "SteamAPI_ManualDispatch_RunFrame", ReturnType: "void", Params: []apiParam{{"hSteamPipe", "HSteamPipe"}}},
+	{NameFlat: "SteamAPI_ManualDispatch_GetNextCallback", ReturnType: "bool", Params: []apiParam{{"hSteamPipe", "HSteamPipe"}, {"pCallbackMsg", "CallbackMsg_t *"}}},
+	{NameFlat: "SteamAPI_ManualDispatch_FreeLastCallback", ReturnType: "void", Params: []apiParam{{"hSteamPipe", "HSteamPipe"}}},
+	{NameFlat: "SteamAPI_ManualDispatch_GetAPICallResult", ReturnType: "bool", Params: []apiParam{{"hSteamPipe", "HSteamPipe"}, {"hSteamAPICall", "SteamAPICall_t"}, {"pCallback", "void *"}, {"cubCallback", "int"}, {"iCallbackExpected", "int"}, {"pbFailed", "bool *"}}},
+}
+
+// bound lists the functions of steam_api.json that are bound, by the interface or the
+// struct declaring them, in the order they are generated. Nothing else of steam_api.json
+// is generated but the enums and typedefs these functions use, so the output only
+// changes with this list and the signatures of the listed functions. Generation fails if
+// a function is missing from steam_api.json or its signature is not supported.
+var bound = []struct {
+	class   string
+	methods []string
+}{
+	{"SteamNetworkingMessage_t", []string{"Release"}},
+	{"ISteamUser", []string{"GetSteamID"}},
+	{"ISteamUtils", []string{"GetAPICallFailureReason", "IsSteamRunningOnSteamDeck", "GetSteamUILanguage"}},
+	{"ISteamMatchmaking", []string{"RequestLobbyList", "GetLobbyByIndex", "CreateLobby", "LeaveLobby"}},
+	{"ISteamUserStats", []string{"RequestCurrentStats", "GetAchievement", "SetAchievement", "ClearAchievement", "StoreStats"}},
+	{"ISteamApps", []string{
+		"BIsSubscribed",
+		"BIsLowViolence",
+		"BIsVACBanned",
+		"GetCurrentGameLanguage",
+		"GetAvailableGameLanguages",
+		"BIsSubscribedApp",
+		"BIsDlcInstalled",
+		"GetEarliestPurchaseUnixTime",
+		"BIsSubscribedFromFreeWeekend",
+		"GetDLCCount",
+		"BGetDLCDataByIndex",
+		"InstallDLC",
+		"UninstallDLC",
+		"GetCurrentBetaName",
+		"MarkContentCorrupt",
+		"GetInstalledDepots",
+		"GetAppInstallDir",
+		"BIsAppInstalled",
+		"GetAppOwner",
+		"GetLaunchQueryParam",
+		"GetDlcDownloadProgress",
+		"GetAppBuildId",
+		"GetFileDetails",
+		"GetLaunchCommandLine",
+		"BIsSubscribedFromFamilySharing",
+	}},
+	{"ISteamRemoteStorage", []string{"FileWrite", "FileRead", "FileDelete", "GetFileSize"}},
+	{"ISteamInput", []string{"Init", "RunFrame", "GetConnectedControllers", "GetInputTypeForHandle"}},
+	{"ISteamNetworkingMessages", []string{
+		"SendMessageToUser",
+		"ReceiveMessagesOnChannel",
+		"AcceptSessionWithUser",
+		"CloseSessionWithUser",
+		"CloseChannelWithUser",
+		"GetSessionConnectionInfo",
+	}},
+}
+
+// primitives maps the C types of steam_api.json to Go.
+var primitives = map[string]string{
+	"bool":               "bool",
+	"char":               "int8",
+	"signed char":        "int8",
+	"unsigned char":      "uint8",
+	"short":              "int16",
+	"unsigned short":     "uint16",
+	"int":                "int32",
+	"unsigned int":       "uint32",
+	"long long":          "int64",
+	"unsigned long long": "uint64",
+	"int8":               "int8",
+	"uint8":              "uint8",
+	"int16":              "int16",
+	"uint16":             "uint16",
+	"int32":              "int32",
+	"uint32":             "uint32",
+	"int64":              "int64",
+	"uint64":             "uint64",
+	"intptr_t":           "uintptr",
+	"uintptr_t":          "uintptr",
+	"size_t":             "uintptr",
+	"float":              "float32",
+	"double":             "float64",
+	"uint64_steamid":     "CSteamID",
+	"uint64_gameid":      "uint64",
+}
+
+// renamed maps the C types of steam_api.json to the Go types of this package with
+// another name.
+var renamed = map[string]string{
+	"SteamAPICall_t": "SteamAPICallbackHandle",
+}
+
+// A class is how a value is passed to and returned from C.
+type class string
+
+const (
+	classVoid  class = "Void"
+	classBool  class = "Bool"
+	classInt32 class = "Int32"
+	classInt64 class = "Int64"
+	classPtr   class = "Ptr"
+)
+
+// classes maps Go basic types to their class. Floating-point values are passed in other
+// registers than integers and are not supported.
+var classes = map[string]class{
+	"bool":    classBool,
+	"int8":    classInt32,
+	"uint8":   classInt32,
+	"int16":   classInt32,
+	"uint16":  classInt32,
+	"int32":   classInt32,
+	"uint32":  classInt32,
+	"int":     classInt32,
+	"uint":    classInt32,
+	"int64":   classInt64,
+	"uint64":  classInt64,
+	"uintptr": classPtr,
+}
+
+// declaredTypes returns the underlying types of the types declared by hand in the
+// package in dir, or "" for those that are not basic types.
+func declaredTypes(dir string) (map[string]string, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !generated[fi.Name()] && !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	types := map[string]string{}
+	for _, pkg := range pkgs {
+		if pkg.Name != "steamworks" {
+			continue
+		}
+		for _, f := range pkg.Files {
+			for _, d := range f.Decls {
+				gd, ok := d.(*ast.GenDecl)
+				if !ok {
+					continue
+				}
+				for _, s := range gd.Specs {
+					switch s := s.(type) {
+					case *ast.TypeSpec:
+						types[s.Name.Name] = ""
+						if id, ok := s.Type.(*ast.Ident); ok {
+							types[s.Name.Name] = id.Name
+						}
+					case *ast.ValueSpec:
+						for _, n := range s.Names {
+							types[n.Name] = "-"
+						}
+					}
+				}
+			}
+		}
+	}
+	return types, nil
+}
+
+type goType struct {
+	name  string
+	class class
+}
+
+type stub struct {
+	id     string // flatAPI identifier
+	symbol string
+	name   string // name of the stub function
+	self   bool
+	params []apiParam
+	types  []goType
+	ret    goType
+	cret   string // C return type
+}
+
+func (s *stub) funcType() string {
+	parts := []string{"funcType", string(s.ret.class)}
+	if s.self {
+		parts = append(parts, string(classPtr))
+	}
+	for _, t := range s.types {
+		parts = append(parts, string(t.class))
+	}
+	return strings.Join(parts, "_")
+}
+
+type generator struct {
+	api      *steamAPI
+	declared map[string]string
+	enums    map[string]*apiEnum
+	typedefs map[string]string
+
+	// used holds the enums and typedefs the stubs refer to.
+	used map[string]bool
+
+	globals    []*stub
+	interfaces []*iface
+}
+
+type iface struct {
+	name     string
+	accessor string // flatAPI identifier of the accessor
+	symbol   string
+	stubs    []*stub
+}
+
+func newGenerator(api *steamAPI, declared map[string]string) (*generator, error) {
+	g := &generator{
+		api:      api,
+		declared: declared,
+		enums:    map[string]*apiEnum{},
+		typedefs: map[string]string{},
+		used:     map[string]bool{},
+	}
+	for i := range api.Enums {
+		g.enums[api.Enums[i].Name] = &api.Enums[i]
+	}
+	for _, t := range api.Typedefs {
+		g.typedefs[t.Name] = t.Type
+	}
+
+	for _, m := range globals {
+		s, err := g.newStub(m, false)
 		if err != nil {
-			return err
+			return nil, err
 		}
+		g.globals = append(g.globals, s)
+	}
 
-		if _, err := io.Copy(out, f); err != nil {
-			return err
+	structs := map[string]*apiStruct{}
+	for i := range api.Structs {
+		structs[api.Structs[i].Name] = &api.Structs[i]
+	}
+	interfaces := map[string]*apiInterface{}
+	for i := range api.Interfaces {
+		interfaces[api.Interfaces[i].Name] = &api.Interfaces[i]
+	}
+	for _, b := range bound {
+		if st, ok := structs[b.class]; ok {
+			stubs, err := g.newStubs(b.class, st.Methods, b.methods)
+			if err != nil {
+				return nil, err
+			}
+			g.globals = append(g.globals, stubs...)
+			continue
+		}
+
+		in, ok := interfaces[b.class]
+		if !ok {
+			return nil, fmt.Errorf("%s is not in steam_api.json", b.class)
+		}
+		i := &iface{
+			name:     in.Name,
+			accessor: "flatAPI_" + strings.TrimPrefix(in.Name, "I"),
 		}
+		for _, a := range in.Accessors {
+			if i.symbol == "" || a.Kind == "user" {
+				i.symbol = a.NameFlat
+			}
+		}
+		stubs, err := g.newStubs(b.class, in.Methods, b.methods)
+		if err != nil {
+			return nil, err
+		}
+		i.stubs = stubs
+		g.interfaces = append(g.interfaces, i)
 	}
+	return g, nil
+}
 
-	return nil
+// newStubs returns the stubs of the methods names of class.
+func (g *generator) newStubs(class string, methods []apiMethod, names []string) ([]*stub, error) {
+	var stubs []*stub
+	for _, name := range names {
+		var m *apiMethod
+		for i := range methods {
+			if methods[i].Name == name {
+				m = &methods[i]
+				break
+			}
+		}
+		if m == nil {
+			return nil, fmt.Errorf("%s::%s is not in steam_api.json", class, name)
+		}
+		s, err := g.newStub(*m, true)
+		if err != nil {
+			return nil, err
+		}
+		stubs = append(stubs, s)
+	}
+	return stubs, nil
+}
+
+func (g *generator) newStub(m apiMethod, self bool) (*stub, error) {
+	name := strings.TrimPrefix(m.NameFlat, "SteamAPI_")
+	s := &stub{
+		id:     "flatAPI_" + name,
+		symbol: m.NameFlat,
+		name:   "steamAPI_" + name,
+		self:   self,
+		params: m.Params,
+		cret:   m.ReturnType,
+	}
+	var ok bool
+	if s.ret, ok = g.goType(m.ReturnType, true); !ok {
+		return nil, fmt.Errorf("%s: return type %s is not supported", m.NameFlat, m.ReturnType)
+	}
+	for _, p := range m.Params {
+		t, ok := g.goType(p.Type, false)
+		if !ok || t.class == classVoid {
+			return nil, fmt.Errorf("%s: type %s of %s is not supported", m.NameFlat, p.Type, p.Name)
+		}
+		s.types = append(s.types, t)
+	}
+	return s, nil
+}
+
+// goType returns the Go type of the C type t.
+func (g *generator) goType(t string, result bool) (goType, bool) {
+	t = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(t), "const "))
+	if strings.HasSuffix(t, "*") || strings.HasSuffix(t, "&") {
+		if result {
+			return goType{"uintptr", classPtr}, true
+		}
+		return goType{"unsafe.Pointer", classPtr}, true
+	}
+	if t == "void" {
+		return goType{"", classVoid}, true
+	}
+	if n, ok := renamed[t]; ok {
+		t = n
+	}
+	if p, ok := primitives[t]; ok {
+		if c, ok := classes[p]; ok {
+			return goType{p, c}, true
+		}
+		if u, ok := g.declared[p]; ok {
+			c, ok := classes[u]
+			return goType{p, c}, ok
+		}
+		return goType{}, false
+	}
+	if _, ok := g.enums[t]; ok {
+		g.used[t] = true
+		return goType{t, classInt32}, true
+	}
+	if u, ok := g.declared[t]; ok {
+		c, ok := classes[u]
+		return goType{t, c}, ok
+	}
+	if u, ok := g.typedefs[t]; ok {
+		ut, ok := g.goType(u, result)
+		if !ok || ut.class == classPtr || ut.class == classVoid {
+			return goType{}, false
+		}
+		g.used[t] = true
+		return goType{t, ut.class}, true
+	}
+	return goType{}, false
+}
+
+func (g *generator) stubs() []*stub {
+	stubs := append([]*stub(nil), g.globals...)
+	for _, i := range g.interfaces {
+		stubs = append(stubs, i.stubs...)
+	}
+	return stubs
+}
+
+func (g *generator) header(buf *bytes.Buffer, constraint string) {
+	buf.WriteString("// SPDX-License-Identifier: Apache-2.0\n")
+	buf.WriteString("// SPDX-FileCopyrightText: 2021 The go-steamworks Authors\n\n")
+	fmt.Fprintf(buf, "// Code generated by gen.go from steam_api.json of Steamworks SDK %s; DO NOT EDIT.\n\n", *version)
+	if constraint != "" {
+		fmt.Fprintf(buf, "//go:build %s\n\n", constraint)
+	}
+	buf.WriteString("package steamworks\n\n")
+}
+
+func (g *generator) flatAPI() []byte {
+	var buf bytes.Buffer
+	g.header(&buf, "")
+
+	buf.WriteString("const (\n")
+	for i, s := range g.globals {
+		if i == 0 {
+			fmt.Fprintf(&buf, "%s flatAPI = iota\n", s.id)
+		} else {
+			fmt.Fprintf(&buf, "%s\n", s.id)
+		}
+	}
+	for _, i := range g.interfaces {
+		fmt.Fprintf(&buf, "\n%s\n", i.accessor)
+		for _, s := range i.stubs {
+			fmt.Fprintf(&buf, "%s\n", s.id)
+		}
+	}
+	buf.WriteString("\nflatAPICount\n)\n\n")
+
+	buf.WriteString("var flatAPINames = [...]string{\n")
+	for _, s := range g.globals {
+		fmt.Fprintf(&buf, "%s: %q,\n", s.id, s.symbol)
+	}
+	for _, i := range g.interfaces {
+		buf.WriteString("\n")
+		if i.symbol != "" {
+			fmt.Fprintf(&buf, "%s: %q,\n", i.accessor, i.symbol)
+		}
+		for _, s := range i.stubs {
+			fmt.Fprintf(&buf, "%s: %q,\n", s.id, s.symbol)
+		}
+	}
+	buf.WriteString("}\n")
+
+	var typedefs []string
+	for t := range g.used {
+		if _, ok := g.typedefs[t]; !ok {
+			continue
+		}
+		if _, ok := g.declared[t]; !ok {
+			typedefs = append(typedefs, t)
+		}
+	}
+	sort.Strings(typedefs)
+	for _, t := range typedefs {
+		u, _ := g.goType(g.typedefs[t], false)
+		fmt.Fprintf(&buf, "\ntype %s %s\n", t, u.name)
+	}
+
+	for _, e := range g.api.Enums {
+		if !g.used[e.Name] || !g.enumFree(&e) {
+			continue
+		}
+		fmt.Fprintf(&buf, "\ntype %s int32\n\nconst (\n", e.Name)
+		for _, v := range e.Values {
+			fmt.Fprintf(&buf, "%s %s = %s\n", strings.TrimPrefix(v.Name, "k_"), e.Name, v.Value)
+		}
+		buf.WriteString(")\n")
+	}
+
+	for _, i := range g.interfaces {
+		g.writeInterface(&buf, i)
+	}
+	return buf.Bytes()
+}
+
+// enumFree reports whether e and its values can be declared without clashing with the
+// declarations made by hand.
+func (g *generator) enumFree(e *apiEnum) bool {
+	if _, ok := g.declared[e.Name]; ok {
+		return false
+	}
+	for _, v := range e.Values {
+		if _, ok := g.declared[strings.TrimPrefix(v.Name, "k_")]; ok {
+			return false
+		}
+	}
+	return true
+}
+
+// writeInterface writes the interface i and its *WithError counterpart, which are
+// shared by all builds.
+func (g *generator) writeInterface(buf *bytes.Buffer, i *iface) {
+	if _, ok := g.declared[i.name]; ok {
+		return
+	}
+	accessor := strings.TrimPrefix(i.name, "I")
+	impl := "steam" + strings.TrimPrefix(i.name, "ISteam") + "WithError"
+
+	fmt.Fprintf(buf, "\ntype %s interface {\n", i.name)
+	for _, s := range i.stubs {
+		fmt.Fprintf(buf, "%s(%s)%s\n", s.method(), s.paramList(), s.resultList())
+	}
+	buf.WriteString("}\n")
+
+	fmt.Fprintf(buf, "\ntype %sWithError interface {\n", i.name)
+	for _, s := range i.stubs {
+		fmt.Fprintf(buf, "%s(%s) %s\n", s.method(), s.paramList(), s.errorResultList())
+	}
+	buf.WriteString("}\n")
+
+	fmt.Fprintf(buf, "\nfunc %sWithError() (%sWithError, error) {\n", accessor, i.name)
+	fmt.Fprintf(buf, "var s %s\nif err := access(func() { s = %s() }); err != nil {\nreturn nil, err\n}\nreturn %s{s}, nil\n}\n", i.name, accessor, impl)
+	fmt.Fprintf(buf, "\ntype %s struct {\ns %s\n}\n", impl, i.name)
+	for _, s := range i.stubs {
+		call := fmt.Sprintf("s.s.%s(%s)", s.method(), s.argList())
+		if s.ret.class == classVoid {
+			fmt.Fprintf(buf, "\nfunc (s %s) %s(%s) error {\nreturn protect(func() { %s })\n}\n", impl, s.method(), s.paramList(), call)
+			continue
+		}
+		fmt.Fprintf(buf, "\nfunc (s %s) %s(%s) (v %s, err error) {\nerr = protect(func() { v = %s })\nreturn\n}\n", impl, s.method(), s.paramList(), s.result(), call)
+	}
+}
+
+// writeImpl writes the accessor of the interface i and its implementation calling the stubs.
+func (g *generator) writeImpl(buf *bytes.Buffer, i *iface) {
+	if _, ok := g.declared[i.name]; ok {
+		return
+	}
+	accessor := strings.TrimPrefix(i.name, "I")
+	impl := "steam" + strings.TrimPrefix(i.name, "ISteam")
+
+	fmt.Fprintf(buf, "\nfunc %s() %s {\n", accessor, i.name)
+	fmt.Fprintf(buf, "v, err := flatIface(%s)\nif err != nil {\npanic(err)\n}\nreturn %s(v)\n}\n", i.accessor, impl)
+	fmt.Fprintf(buf, "\ntype %s uintptr\n", impl)
+	for _, s := range i.stubs {
+		fmt.Fprintf(buf, "\nfunc (s %s) %s(%s)%s {\n", impl, s.method(), s.paramList(), s.resultList())
+		call := fmt.Sprintf("%s(uintptr(s)", s.name)
+		if len(s.params) > 0 {
+			call += ", " + s.argList()
+		}
+		call += ")"
+		switch {
+		case s.ret.class == classVoid:
+			fmt.Fprintf(buf, "if err := %s; err != nil {\npanic(err)\n}\n}\n", call)
+		case s.returnsString():
+			fmt.Fprintf(buf, "v, err := %s\nif err != nil {\npanic(err)\n}\nreturn goString(v)\n}\n", call)
+		default:
+			fmt.Fprintf(buf, "v, err := %s\nif err != nil {\npanic(err)\n}\nreturn v\n}\n", call)
+		}
+	}
+}
+
+// writeNoSteamImpl writes the accessor of the interface i and its implementation for the
+// nosteam tag, whose methods return zero values.
+func (g *generator) writeNoSteamImpl(buf *bytes.Buffer, i *iface) {
+	if _, ok := g.declared[i.name]; ok {
+		return
+	}
+	accessor := strings.TrimPrefix(i.name, "I")
+	impl := "steam" + strings.TrimPrefix(i.name, "ISteam")
+
+	fmt.Fprintf(buf, "\nfunc %s() %s {\nreturn %s{}\n}\n", accessor, i.name, impl)
+	fmt.Fprintf(buf, "\ntype %s struct{}\n", impl)
+	for _, s := range i.stubs {
+		fmt.Fprintf(buf, "\nfunc (%s) %s(%s)%s {\n", impl, s.method(), s.paramList(), s.resultList())
+		switch {
+		case s.ret.class == classVoid:
+		case s.returnsString():
+			buf.WriteString("return \"\"\n")
+		case s.ret.class == classBool:
+			buf.WriteString("return false\n")
+		default:
+			buf.WriteString("return 0\n")
+		}
+		buf.WriteString("}\n")
+	}
+}
+
+// noSteam returns nil if all the interfaces are defined by hand.
+func (g *generator) noSteam() []byte {
+	var buf bytes.Buffer
+	g.header(&buf, "nosteam")
+	n := buf.Len()
+	for _, i := range g.interfaces {
+		g.writeNoSteamImpl(&buf, i)
+	}
+	if buf.Len() == n {
+		return nil
+	}
+	return buf.Bytes()
+}
+
+func (s *stub) method() string {
+	return s.symbol[strings.LastIndex(s.symbol, "_")+1:]
+}
+
+func (s *stub) paramList() string {
+	var ps []string
+	for i, p := range s.params {
+		ps = append(ps, paramName(p.Name)+" "+s.types[i].name)
+	}
+	return strings.Join(ps, ", ")
+}
+
+func (s *stub) argList() string {
+	var args []string
+	for _, p := range s.params {
+		args = append(args, paramName(p.Name))
+	}
+	return strings.Join(args, ", ")
+}
+
+// returnsString reports whether the function returns a C string.
+func (s *stub) returnsString() bool {
+	return strings.Join(strings.Fields(s.cret), " ") == "const char *"
+}
+
+// result returns the Go result type of the interface method.
+func (s *stub) result() string {
+	if s.returnsString() {
+		return "string"
+	}
+	return s.ret.name
+}
+
+func (s *stub) resultList() string {
+	if s.ret.class == classVoid {
+		return ""
+	}
+	return " " + s.result()
+}
+
+func (s *stub) errorResultList() string {
+	if s.ret.class == classVoid {
+		return "error"
+	}
+	return "(" + s.result() + ", error)"
+}
+
+func paramName(name string) string {
+	switch name {
+	case "type", "func", "range", "map", "chan", "string", "len", "cap":
+		return name + "_"
+	}
+	return name
+}
+
+func (g *generator) funcTypes() []string {
+	seen := map[string]bool{
+		// Interface accessors.
+		"funcType_Ptr": true,
+	}
+	for _, s := range g.stubs() {
+		seen[s.funcType()] = true
+	}
+	var types []string
+	for t := range seen {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+func (g *generator) usesPointers() bool {
+	for _, s := range g.stubs() {
+		for _, t := range s.types {
+			if t.name == "unsafe.Pointer" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (g *generator) flatCalls() []byte {
+	var buf bytes.Buffer
+	g.header(&buf, "!nosteam")
+	if g.usesPointers() {
+		buf.WriteString("import \"unsafe\"\n\n")
+	}
+
+	buf.WriteString("// funcType describes the C signature of a function, which only some backends need.\n")
+	buf.WriteString("type funcType int\n\nconst (\n")
+	for i, t := range g.funcTypes() {
+		if i == 0 {
+			fmt.Fprintf(&buf, "%s funcType = iota\n", t)
+		} else {
+			fmt.Fprintf(&buf, "%s\n", t)
+		}
+	}
+	buf.WriteString(")\n\n")
+
+	buf.WriteString("// returnsInt64 reports whether functions of type f return a 64-bit integer.\n")
+	buf.WriteString("func (f funcType) returnsInt64() bool {\nswitch f {\ncase ")
+	var int64s []string
+	for _, t := range g.funcTypes() {
+		if strings.HasPrefix(t, "funcType_Int64") {
+			int64s = append(int64s, t)
+		}
+	}
+	if len(int64s) == 0 {
+		int64s = append(int64s, "-1")
+	}
+	buf.WriteString(strings.Join(int64s, ", "))
+	buf.WriteString(":\nreturn true\n}\nreturn false\n}\n")
+
+	for _, s := range g.stubs() {
+		// A 64-bit integer is passed in two words on 32-bit platforms, the low one first,
+		// so the stubs taking one pass other arguments there.
+		var ps, args, args32 []string
+		split := false
+		if s.self {
+			ps = append(ps, "self uintptr")
+			args = append(args, "self")
+			args32 = append(args32, "self")
+		}
+		for i, p := range s.params {
+			n, t := paramName(p.Name), s.types[i]
+			ps = append(ps, n+" "+t.name)
+			switch t.class {
+			case classBool:
+				args = append(args, "cBool("+n+")")
+				args32 = append(args32, "cBool("+n+")")
+			case classInt64:
+				args = append(args, "uintptr("+n+")")
+				args32 = append(args32, "uintptr("+n+")", "uintptr(uint64("+n+")>>32)")
+				split = true
+			default:
+				args = append(args, "uintptr("+n+")")
+				args32 = append(args32, "uintptr("+n+")")
+			}
+		}
+		call := func(args []string) string {
+			c := fmt.Sprintf("callFlat(%s, %s", s.funcType(), s.id)
+			if len(args) > 0 {
+				c += ", " + strings.Join(args, ", ")
+			}
+			return c + ")"
+		}
+
+		v := "v"
+		if s.ret.class == classVoid {
+			v = "_"
+		}
+		var body string
+		if split {
+			if v == "v" {
+				body = "var v uint64\n"
+			}
+			body += fmt.Sprintf("var err error\nif is32Bit {\n%s, err = %s\n} else {\n%s, err = %s\n}\n", v, call(args32), v, call(args))
+		} else {
+			body = fmt.Sprintf("%s, err := %s\n", v, call(args))
+		}
+
+		fmt.Fprintf(&buf, "\nfunc %s(%s) ", s.name, strings.Join(ps, ", "))
+		switch s.ret.class {
+		case classVoid:
+			fmt.Fprintf(&buf, "error {\n%sreturn err\n}\n", body)
+		case classBool:
+			fmt.Fprintf(&buf, "(bool, error) {\n%sreturn byte(v) != 0, err\n}\n", body)
+		default:
+			fmt.Fprintf(&buf, "(%s, error) {\n%sreturn %s(v), err\n}\n", s.ret.name, body, s.ret.name)
+		}
+	}
+
+	for _, i := range g.interfaces {
+		g.writeImpl(&buf, i)
+	}
+	return buf.Bytes()
+}
+
+var cTypes = map[class]string{
+	classVoid:  "void",
+	classBool:  "uint8_t",
+	classInt32: "int32_t",
+	classInt64: "int64_t",
+	classPtr:   "uintptr_t",
+}
+
+var cFuncTypes = map[class]string{
+	classVoid:  "void",
+	classBool:  "bool",
+	classInt32: "int32_t",
+	classInt64: "int64_t",
+	classPtr:   "void*",
+}
+
+func (g *generator) trampolines() []byte {
+	var buf bytes.Buffer
+	g.header(&buf, "linux && 386 && !nosteam")
+
+	type sig struct {
+		name   string
+		ret    class
+		params []class
+	}
+	var sigs []sig
+	for _, t := range g.funcTypes() {
+		parts := strings.Split(strings.TrimPrefix(t, "funcType_"), "_")
+		s := sig{name: strings.TrimPrefix(t, "funcType_"), ret: class(parts[0])}
+		for _, p := range parts[1:] {
+			s.params = append(s.params, class(p))
+		}
+		sigs = append(sigs, s)
+	}
+
+	// The stubs pass a 64-bit integer in two words, the low one first, which the
+	// trampolines join again.
+	buf.WriteString("// #include <stdbool.h>\n// #include <stdint.h>\n")
+	for _, s := range sigs {
+		var ps, fps, args []string
+		ps = append(ps, "uintptr_t f")
+		for i, p := range s.params {
+			fps = append(fps, cFuncTypes[p])
+			switch p {
+			case classInt64:
+				ps = append(ps, fmt.Sprintf("uint32_t arg%dlo, uint32_t arg%dhi", i, i))
+				args = append(args, fmt.Sprintf("(int64_t)((uint64_t)arg%dhi << 32 | arg%dlo)", i, i))
+				continue
+			case classPtr:
+				args = append(args, fmt.Sprintf("(void*)arg%d", i))
+			case classBool:
+				args = append(args, fmt.Sprintf("(bool)arg%d", i))
+			default:
+				args = append(args, fmt.Sprintf("arg%d", i))
+			}
+			ps = append(ps, fmt.Sprintf("%s arg%d", cTypes[p], i))
+		}
+		call := fmt.Sprintf("((%s (*)(%s))(f))(%s)", cFuncTypes[s.ret], strings.Join(fps, ", "), strings.Join(args, ", "))
+		ret := cTypes[s.ret]
+		buf.WriteString("//\n")
+		fmt.Fprintf(&buf, "// static %s callFunc_%s(%s) {\n", ret, s.name, strings.Join(ps, ", "))
+		switch s.ret {
+		case classVoid:
+			fmt.Fprintf(&buf, "//   %s;\n", call)
+		case classPtr:
+			fmt.Fprintf(&buf, "//   return (uintptr_t)%s;\n", call)
+		default:
+			fmt.Fprintf(&buf, "//   return %s;\n", call)
+		}
+		buf.WriteString("// }\n")
+	}
+	buf.WriteString("import \"C\"\n\nimport \"fmt\"\n\n")
+
+	buf.WriteString("func callProc(ftype funcType, f uintptr, args ...uintptr) (uint64, error) {\nswitch ftype {\n")
+	for _, s := range sigs {
+		args := []string{"C.uintptr_t(f)"}
+		n := 0
+		for _, p := range s.params {
+			if p == classInt64 {
+				args = append(args, fmt.Sprintf("C.uint32_t(args[%d])", n), fmt.Sprintf("C.uint32_t(args[%d])", n+1))
+				n += 2
+				continue
+			}
+			args = append(args, fmt.Sprintf("C.%s(args[%d])", cTypes[p], n))
+			n++
+		}
+		call := fmt.Sprintf("C.callFunc_%s(%s)", s.name, strings.Join(args, ", "))
+		fmt.Fprintf(&buf, "case funcType_%s:\n", s.name)
+		if s.ret == classVoid {
+			fmt.Fprintf(&buf, "%s\nreturn 0, nil\n", call)
+		} else {
+			fmt.Fprintf(&buf, "return uint64(%s), nil\n", call)
+		}
+	}
+	buf.WriteString("}\n\nreturn 0, fmt.Errorf(\"steamworks: function type %d not implemented\", ftype)\n}\n")
+	return buf.Bytes()
 }
@@ -0,0 +1,634 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+//go:build ignore
+
+// gencallbacks generates callbacks.gen.go, the Go definitions of the callback structs
+// declared in api.gen.h, and callbacks.gen_test.go, which lists them for the tests. The
+// expected C layouts are measured by compiling api.gen.h with a C++ compiler for 64-bit
+// and 32-bit x86, each with the packing of Linux and macOS and with the packing of
+// Windows. The layouts are read from the object file, so no 32-bit libraries are needed.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"debug/elf"
+	"fmt"
+	"go/format"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// callbackIDs are the k_iCallback values of the callback structs, which api.gen.h lacks.
+var callbackIDs = map[string]int{
+	"SteamServersConnected_t":         101,
+	"SteamServerConnectFailure_t":     102,
+	"SteamServersDisconnected_t":      103,
+	"ClientGameServerDeny_t":          113,
+	"GSPolicyResponse_t":              115,
+	"IPCFailure_t":                    117,
+	"LicensesUpdated_t":               125,
+	"ValidateAuthTicketResponse_t":    143,
+	"MicroTxnAuthorizationResponse_t": 152,
+	"EncryptedAppTicketResponse_t":    154,
+	"GetAuthSessionTicketResponse_t":  163,
+	"GameWebCallback_t":               164,
+	"StoreAuthURLResponse_t":          165,
+
+	"GSClientApprove_t":                     201,
+	"GSClientDeny_t":                        202,
+	"GSClientKick_t":                        203,
+	"GSClientAchievementStatus_t":           206,
+	"GSGameplayStats_t":                     207,
+	"GSClientGroupStatus_t":                 208,
+	"GSReputation_t":                        209,
+	"AssociateWithClanResult_t":             210,
+	"ComputeNewPlayerCompatibilityResult_t": 211,
+
+	"PersonaStateChange_t":               304,
+	"GameOverlayActivated_t":             331,
+	"GameServerChangeRequested_t":        332,
+	"GameLobbyJoinRequested_t":           333,
+	"AvatarImageLoaded_t":                334,
+	"ClanOfficerListResponse_t":          335,
+	"FriendRichPresenceUpdate_t":         336,
+	"GameRichPresenceJoinRequested_t":    337,
+	"GameConnectedClanChatMsg_t":         338,
+	"GameConnectedChatJoin_t":            339,
+	"GameConnectedChatLeave_t":           340,
+	"DownloadClanActivityCountsResult_t": 341,
+	"JoinClanChatRoomCompletionResult_t": 342,
+	"GameConnectedFriendChatMsg_t":       343,
+	"FriendsGetFollowerCount_t":          344,
+	"FriendsIsFollowing_t":               345,
+	"FriendsEnumerateFollowingList_t":    346,
+	"SetPersonaNameResponse_t":           347,
+
+	"FavoritesListChanged_t":         502,
+	"LobbyInvite_t":                  503,
+	"LobbyEnter_t":                   504,
+	"LobbyDataUpdate_t":              505,
+	"LobbyChatUpdate_t":              506,
+	"LobbyChatMsg_t":                 507,
+	"LobbyGameCreated_t":             509,
+	"LobbyMatchList_t":               510,
+	"LobbyKicked_t":                  512,
+	"LobbyCreated_t":                 513,
+	"PSNGameBootInviteResult_t":      515,
+	"FavoritesListAccountsUpdated_t": 516,
+
+	"IPCountry_t":                 701,
+	"LowBatteryPower_t":           702,
+	"SteamAPICallCompleted_t":     703,
+	"SteamShutdown_t":             704,
+	"CheckFileSignature_t":        705,
+	"GamepadTextInputDismissed_t": 714,
+
+	"DlcInstalled_t":                   1005,
+	"RegisterActivationCodeResponse_t": 1008,
+	"NewLaunchQueryParameters_t":       1014,
+	"AppProofOfPurchaseKeyResponse_t":  1021,
+	"FileDetailsResult_t":              1023,
+
+	"UserStatsReceived_t":                 1101,
+	"UserStatsStored_t":                   1102,
+	"UserAchievementStored_t":             1103,
+	"LeaderboardFindResult_t":             1104,
+	"LeaderboardScoresDownloaded_t":       1105,
+	"LeaderboardScoreUploaded_t":          1106,
+	"NumberOfCurrentPlayers_t":            1107,
+	"UserStatsUnloaded_t":                 1108,
+	"UserAchievementIconFetched_t":        1109,
+	"GlobalAchievementPercentagesReady_t": 1110,
+	"LeaderboardUGCSet_t":                 1111,
+	"PS3TrophiesInstalled_t":              1112,
+	"GlobalStatsReceived_t":               1112,
+
+	"SocketStatusCallback_t":  1201,
+	"P2PSessionRequest_t":     1202,
+	"P2PSessionConnectFail_t": 1203,
+
+	"RemoteStorageAppSyncedClient_t":                           1301,
+	"RemoteStorageAppSyncedServer_t":                           1302,
+	"RemoteStorageAppSyncProgress_t":                           1303,
+	"RemoteStorageAppSyncStatusCheck_t":                        1305,
+	"RemoteStorageFileShareResult_t":                           1307,
+	"RemoteStoragePublishFileResult_t":                         1309,
+	"RemoteStorageDeletePublishedFileResult_t":                 1311,
+	"RemoteStorageEnumerateUserPublishedFilesResult_t":         1312,
+	"RemoteStorageSubscribePublishedFileResult_t":              1313,
+	"RemoteStorageEnumerateUserSubscribedFilesResult_t":        1314,
+	"RemoteStorageUnsubscribePublishedFileResult_t":            1315,
+	"RemoteStorageUpdatePublishedFileResult_t":                 1316,
+	"RemoteStorageDownloadUGCResult_t":                         1317,
+	"RemoteStorageGetPublishedFileDetailsResult_t":             1318,
+	"RemoteStorageEnumerateWorkshopFilesResult_t":              1319,
+	"RemoteStorageGetPublishedItemVoteDetailsResult_t":         1320,
+	"RemoteStoragePublishedFileSubscribed_t":                   1321,
+	"RemoteStoragePublishedFileUnsubscribed_t":                 1322,
+	"RemoteStoragePublishedFileDeleted_t":                      1323,
+	"RemoteStorageUpdateUserPublishedItemVoteResult_t":         1324,
+	"RemoteStorageUserVoteDetails_t":                           1325,
+	"RemoteStorageEnumerateUserSharedWorkshopFilesResult_t":    1326,
+	"RemoteStorageSetUserPublishedFileActionResult_t":          1327,
+	"RemoteStorageEnumeratePublishedFilesByUserActionResult_t": 1328,
+	"RemoteStoragePublishFileProgress_t":                       1329,
+	"RemoteStoragePublishedFileUpdated_t":                      1330,
+	"RemoteStorageFileWriteAsyncComplete_t":                    1331,
+	"RemoteStorageFileReadAsyncComplete_t":                     1332,
+
+	"GCMessageAvailable_t": 1701,
+	"GCMessageFailed_t":    1702,
+
+	"GSStatsReceived_t": 1800,
+	"GSStatsStored_t":   1801,
+	"GSStatsUnloaded_t": 1108,
+
+	"HTTPRequestCompleted_t":       2101,
+	"HTTPRequestHeadersReceived_t": 2102,
+	"HTTPRequestDataReceived_t":    2103,
+
+	"ScreenshotReady_t":     2301,
+	"ScreenshotRequested_t": 2302,
+
+	"SteamUGCQueryCompleted_t":          3401,
+	"SteamUGCRequestUGCDetailsResult_t": 3402,
+	"CreateItemResult_t":                3403,
+	"SubmitItemUpdateResult_t":          3404,
+	"ItemInstalled_t":                   3405,
+	"DownloadItemResult_t":              3406,
+	"UserFavoriteItemsListChanged_t":    3407,
+	"SetUserItemVoteResult_t":           3408,
+	"GetUserItemVoteResult_t":           3409,
+	"StartPlaytimeTrackingResult_t":     3410,
+	"StopPlaytimeTrackingResult_t":      3411,
+	"AddUGCDependencyResult_t":          3412,
+	"RemoveUGCDependencyResult_t":       3413,
+	"AddAppDependencyResult_t":          3414,
+	"RemoveAppDependencyResult_t":       3415,
+	"GetAppDependenciesResult_t":        3416,
+	"DeleteItemResult_t":                3417,
+
+	"SteamAppInstalled_t":   3901,
+	"SteamAppUninstalled_t": 3902,
+
+	"PlaybackStatusHasChanged_t": 4001,
+	"VolumeHasChanged_t":         4002,
+
+	"MusicPlayerRemoteWillActivate_t":       4101,
+	"MusicPlayerRemoteWillDeactivate_t":     4102,
+	"MusicPlayerRemoteToFront_t":            4103,
+	"MusicPlayerWillQuit_t":                 4104,
+	"MusicPlayerWantsPlay_t":                4105,
+	"MusicPlayerWantsPause_t":               4106,
+	"MusicPlayerWantsPlayPrevious_t":        4107,
+	"MusicPlayerWantsPlayNext_t":            4108,
+	"MusicPlayerWantsShuffled_t":            4109,
+	"MusicPlayerWantsLooped_t":              4110,
+	"MusicPlayerWantsVolume_t":              4111,
+	"MusicPlayerSelectsQueueEntry_t":        4112,
+	"MusicPlayerSelectsPlaylistEntry_t":     4113,
+	"MusicPlayerWantsPlayingRepeatStatus_t": 4114,
+
+	"HTML_BrowserReady_t":        4501,
+	"HTML_NeedsPaint_t":          4502,
+	"HTML_StartRequest_t":        4503,
+	"HTML_CloseBrowser_t":        4504,
+	"HTML_URLChanged_t":          4505,
+	"HTML_FinishedRequest_t":     4506,
+	"HTML_OpenLinkInNewTab_t":    4507,
+	"HTML_ChangedTitle_t":        4508,
+	"HTML_SearchResults_t":       4509,
+	"HTML_CanGoBackAndForward_t": 4510,
+	"HTML_HorizontalScroll_t":    4511,
+	"HTML_VerticalScroll_t":      4512,
+	"HTML_LinkAtPosition_t":      4513,
+	"HTML_JSAlert_t":             4514,
+	"HTML_JSConfirm_t":           4515,
+	"HTML_FileOpenDialog_t":      4516,
+	"HTML_NewWindow_t":           4521,
+	"HTML_SetCursor_t":           4522,
+	"HTML_StatusText_t":          4523,
+	"HTML_ShowToolTip_t":         4524,
+	"HTML_UpdateToolTip_t":       4525,
+	"HTML_HideToolTip_t":         4526,
+	"HTML_BrowserRestarted_t":    4527,
+
+	"BroadcastUploadStart_t": 4604,
+	"BroadcastUploadStop_t":  4605,
+	"GetVideoURLResult_t":    4611,
+	"GetOPFSettingsResult_t": 4624,
+
+	"SteamInventoryResultReady_t":             4700,
+	"SteamInventoryFullUpdate_t":              4701,
+	"SteamInventoryDefinitionUpdate_t":        4702,
+	"SteamInventoryEligiblePromoItemDefIDs_t": 4703,
+	"SteamInventoryStartPurchaseResult_t":     4704,
+	"SteamInventoryRequestPricesResult_t":     4705,
+
+	"SteamParentalSettingsChanged_t": 5001,
+}
+
+// handWritten are the structs already defined in steamworks.go.
+var handWritten = map[string]bool{
+	"CallbackMsg_t":           true,
+	"LobbyCreated_t":          true,
+	"LobbyEnter_t":            true,
+	"LobbyMatchList_t":        true,
+	"SteamAPICallCompleted_t": true,
+}
+
+// renames are the Go names of the structs whose C names are not exported.
+var renames = map[string]string{
+	"gameserveritem_t": "GameServerItem_t",
+	"servernetadr_t":   "ServerNetAdr_t",
+}
+
+// goTypes are the C types with a Go counterpart of the same name.
+var goTypes = map[string]bool{
+	"AppId_t":    true,
+	"EResult":    true,
+	"HSteamUser": true,
+}
+
+var primitives = map[string]string{
+	"bool":     "bool",
+	"char":     "byte",
+	"int":      "int32",
+	"float":    "float32",
+	"double":   "float64",
+	"int8_t":   "int8",
+	"uint8_t":  "uint8",
+	"int16_t":  "int16",
+	"uint16_t": "uint16",
+	"int32_t":  "int32",
+	"uint32_t": "uint32",
+	"int64_t":  "int64",
+	"uint64_t": "uint64",
+
+	"uint64aligned": "uint64",
+	"int64aligned":  "int64",
+}
+
+// fieldPrefixes are the Hungarian prefixes of the field names of api.gen.h, such as the
+// n of m_nAppID, which the Go field names drop, in the order they are tried.
+var fieldPrefixes = []struct {
+	prefix, replacement string
+}{
+	{"Rgch", ""},
+	{"Rgf", ""},
+	{"Rg", ""},
+	{"Rtime", "Time"},
+	{"Pch", ""},
+	{"Pp", ""},
+	{"Pub", ""},
+	{"Cch", ""},
+	{"Cub", ""},
+	{"Sz", ""},
+	{"Fl", ""},
+	{"Ul", ""},
+	{"Un", ""},
+	{"Ui", ""},
+	{"Us", ""},
+	{"B", ""},
+	{"C", ""},
+	{"D", ""},
+	{"E", ""},
+	{"F", ""},
+	{"H", ""},
+	{"I", ""},
+	{"N", ""},
+	{"P", ""},
+	{"U", ""},
+}
+
+// goFieldName returns the Go name of the field named name in api.gen.h, without its
+// Hungarian prefix: AppID for NAppID, FileSize for UlFileSize and Name for RgchName.
+func goFieldName(name string) string {
+	for _, p := range fieldPrefixes {
+		rest := strings.TrimPrefix(name, p.prefix)
+		if rest != name && rest != "" && rest[0] >= 'A' && rest[0] <= 'Z' {
+			return p.replacement + rest
+		}
+	}
+	return name
+}
+
+type field struct {
+	name  string
+	ctype string
+	ptr   bool
+	array int
+}
+
+type cstruct struct {
+	name   string
+	fields []field
+}
+
+type layout struct {
+	size    int
+	offsets []int
+}
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	typedefs, structs, err := parseHeader("api.gen.h")
+	if err != nil {
+		return err
+	}
+
+	// The order of the targets is the order of the layouts in expectedLayouts. MSVC
+	// aligns 64-bit integers to 8 bytes on 32-bit Windows, unlike GCC by default.
+	targets := [][]string{
+		{"-m64"},
+		{"-m64", "-U__linux__"},
+		{"-m32"},
+		{"-m32", "-malign-double", "-U__linux__"},
+	}
+	measured := make([]map[string]layout, len(targets))
+	for i, flags := range targets {
+		if measured[i], err = measure(structs, flags); err != nil {
+			return err
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(`// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+// Code generated by gencallbacks.go from api.gen.h; DO NOT EDIT.
+
+package steamworks
+
+`)
+
+	var names []string
+	for _, s := range structs {
+		if _, ok := callbackIDs[s.name]; ok {
+			names = append(names, s.name)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if callbackIDs[names[i]] != callbackIDs[names[j]] {
+			return callbackIDs[names[i]] < callbackIDs[names[j]]
+		}
+		return names[i] < names[j]
+	})
+
+	buf.WriteString("// The k_iCallback values of the callback structs.\nconst (\n")
+	for _, n := range names {
+		fmt.Fprintf(&buf, "CallbackID_%s int32 = %d\n", n, callbackIDs[n])
+	}
+	buf.WriteString(")\n\n")
+
+	for _, s := range structs {
+		if !handWritten[s.name] {
+			fmt.Fprintf(&buf, "type %s struct {\n", goName(s.name))
+			seen := map[string]bool{}
+			for _, f := range s.fields {
+				name := goFieldName(f.name)
+				if seen[name] {
+					return fmt.Errorf("api.gen.h: field %s of %s clashes with another field once renamed to %s", f.name, s.name, name)
+				}
+				seen[name] = true
+				fmt.Fprintf(&buf, "%s %s\n", name, goType(f, typedefs))
+			}
+			buf.WriteString("}\n\n")
+		}
+		if _, ok := callbackIDs[s.name]; ok {
+			fmt.Fprintf(&buf, "func (%s) CallbackID() int32 { return CallbackID_%s }\n\n", s.name, s.name)
+		}
+	}
+
+	buf.WriteString(`// expectedLayouts are the sizes and field offsets of the structs as measured in api.gen.h:
+// on 64-bit targets packed to 4 bytes (Linux and macOS) and to 8 bytes (Windows), then
+// on 32-bit targets packed the same way.
+var expectedLayouts = map[string][4]expectedLayout{
+`)
+	for _, s := range structs {
+		var lits []string
+		for _, m := range measured {
+			lits = append(lits, layoutLit(m[s.name]))
+		}
+		fmt.Fprintf(&buf, "%q: {%s},\n", goName(s.name), strings.Join(lits, ", "))
+	}
+	buf.WriteString("}\n")
+
+	if err := writeSource("callbacks.gen.go", buf.Bytes()); err != nil {
+		return err
+	}
+
+	buf.Reset()
+	buf.WriteString(`// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+// Code generated by gencallbacks.go from api.gen.h; DO NOT EDIT.
+
+package steamworks
+
+// generatedStructs are zero values of the structs declared in api.gen.h.
+var generatedStructs = []interface{}{
+`)
+	for _, s := range structs {
+		fmt.Fprintf(&buf, "%s{},\n", goName(s.name))
+	}
+	buf.WriteString("}\n")
+	return writeSource("callbacks.gen_test.go", buf.Bytes())
+}
+
+func writeSource(path string, src []byte) error {
+	src, err := format.Source(src)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, src, 0644)
+}
+
+func goName(name string) string {
+	if n, ok := renames[name]; ok {
+		return n
+	}
+	return name
+}
+
+func layoutLit(l layout) string {
+	var offsets []string
+	for _, o := range l.offsets {
+		offsets = append(offsets, strconv.Itoa(o))
+	}
+	return fmt.Sprintf("{%d, []uintptr{%s}}", l.size, strings.Join(offsets, ", "))
+}
+
+func goType(f field, typedefs map[string]string) string {
+	var t string
+	switch {
+	case f.ptr:
+		t = "uintptr"
+	case goTypes[f.ctype]:
+		t = f.ctype
+	case f.ctype == "uint64aligned" && strings.Contains(f.name, "SteamID"):
+		t = "CSteamID"
+	default:
+		c := f.ctype
+		for typedefs[c] != "" {
+			c = typedefs[c]
+		}
+		if p, ok := primitives[c]; ok {
+			t = p
+		} else {
+			// A struct declared in the header.
+			t = goName(c)
+		}
+	}
+	if f.array > 0 {
+		t = fmt.Sprintf("[%d]%s", f.array, t)
+	}
+	return t
+}
+
+var (
+	typedefRE = regexp.MustCompile(`^typedef ([A-Za-z0-9_]+) ([A-Za-z0-9_]+);$`)
+	structRE  = regexp.MustCompile(`^struct ([A-Za-z0-9_]+) \{$`)
+	fieldRE   = regexp.MustCompile(`^\s*(?:const )?(?:struct )?([A-Za-z0-9_]+) (\**) ?([A-Za-z0-9_]+)(?:\[(\d+)\])?;$`)
+)
+
+func parseHeader(path string) (map[string]string, []*cstruct, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	typedefs := map[string]string{}
+	var structs []*cstruct
+	var cur *cstruct
+
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := s.Text()
+		if cur != nil {
+			if line == "};" {
+				structs = append(structs, cur)
+				cur = nil
+				continue
+			}
+			m := fieldRE.FindStringSubmatch(line)
+			if m == nil {
+				return nil, nil, fmt.Errorf("%s: cannot parse field %q of %s", path, line, cur.name)
+			}
+			fl := field{name: m[3], ctype: m[1], ptr: m[2] != ""}
+			if m[4] != "" {
+				fl.array, _ = strconv.Atoi(m[4])
+			}
+			cur.fields = append(cur.fields, fl)
+			continue
+		}
+		if m := typedefRE.FindStringSubmatch(line); m != nil {
+			typedefs[m[2]] = m[1]
+		}
+		if m := structRE.FindStringSubmatch(line); m != nil {
+			cur = &cstruct{name: m[1]}
+		}
+	}
+	return typedefs, structs, s.Err()
+}
+
+// measure compiles a table of the sizes and field offsets of the structs for the target
+// selected by flags, and reads it from the object file.
+func measure(structs []*cstruct, flags []string) (map[string]layout, error) {
+	dir, err := os.MkdirTemp("", "gencallbacks")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	var src bytes.Buffer
+	src.WriteString("#include <stddef.h>\n#include \"api.gen.h\"\nextern \"C\" const unsigned long long layouts[] = {\n")
+	for _, s := range structs {
+		fmt.Fprintf(&src, "\tsizeof(%s),\n", s.name)
+		for _, f := range s.fields {
+			fmt.Fprintf(&src, "\toffsetof(%s, %s),\n", s.name, f.name)
+		}
+	}
+	src.WriteString("};\n")
+
+	cpp := filepath.Join(dir, "layout.cpp")
+	if err := os.WriteFile(cpp, src.Bytes(), 0644); err != nil {
+		return nil, err
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	obj := filepath.Join(dir, "layout.o")
+	args := append([]string{"-ffreestanding", "-w", "-fpermissive", "-I", wd, "-c", "-o", obj, cpp}, flags...)
+	if out, err := exec.Command("c++", args...).CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("c++: %v\n%s", err, out)
+	}
+	values, err := readTable(obj, "layouts")
+	if err != nil {
+		return nil, err
+	}
+
+	n := 0
+	for _, s := range structs {
+		n += 1 + len(s.fields)
+	}
+	if len(values) != n {
+		return nil, fmt.Errorf("%s: %d layout values, want %d", obj, len(values), n)
+	}
+
+	layouts := map[string]layout{}
+	for _, s := range structs {
+		l := layout{size: int(values[0])}
+		for i := range s.fields {
+			l.offsets = append(l.offsets, int(values[1+i]))
+		}
+		values = values[1+len(s.fields):]
+		layouts[s.name] = l
+	}
+	return layouts, nil
+}
+
+// readTable returns the unsigned long long array named sym in the object file at path.
+func readTable(path, sym string) ([]uint64, error) {
+	f, err := elf.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	syms, err := f.Symbols()
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range syms {
+		if s.Name != sym {
+			continue
+		}
+		data, err := f.Sections[s.Section].Data()
+		if err != nil {
+			return nil, err
+		}
+		data = data[s.Value : s.Value+s.Size]
+		values := make([]uint64, len(data)/8)
+		for i := range values {
+			values[i] = f.ByteOrder.Uint64(data[8*i:])
+		}
+		return values, nil
+	}
+	return nil, fmt.Errorf("%s: no symbol %s", path, sym)
+}
@@ -1,5 +1,9 @@
 module github.com/assemblaj/go-steamworks
 
-go 1.17
+go 1.18
 
-require golang.org/x/sys v0.0.0-20210823070655-63515b42dcdf
+require (
+	github.com/ebitengine/purego v0.8.4
+	golang.org/x/sys v0.5.0
+	golang.org/x/text v0.14.0
+)
@@ -0,0 +1,721 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+//go:build !nosteam
+
+package steamworks
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+// The wrappers below call the typed stubs of flatcalls.gen.go, which callFlat dispatches
+// to the library of the platform.
+
+const is32Bit = unsafe.Sizeof(uintptr(0)) == 4
+
+func cBool(x bool) uintptr {
+	if x {
+		return 1
+	}
+	return 0
+}
+
+func Init() bool {
+	v, err := steamAPI_Init()
+	if err != nil {
+		panic(err)
+	}
+	if !v {
+		return false
+	}
+	return enableDispatch() == nil
+}
+
+// InitWithError is like Init, but reports why the Steam API could not be initialized.
+// The returned error is an *InitError.
+func InitWithError() error {
+	t, err := loadedProcs()
+	if err != nil {
+		return &InitError{Failure: InitFailureLibraryNotLoaded, Err: err}
+	}
+	if err := initSteamAPI(t); err != nil {
+		return err
+	}
+	if err := enableDispatch(); err != nil {
+		return initCallError(err)
+	}
+	return nil
+}
+
+// enableDispatch puts the initialized Steam API in manual dispatch mode, which the
+// handlers of callbacks and call results rely on. The Steam API is shut down again if
+// that fails.
+func enableDispatch() error {
+	if err := theDispatcher.enable(); err != nil {
+		steamAPI_Shutdown()
+		return err
+	}
+	return nil
+}
+
+func initSteamAPI(t *procTable) error {
+	if _, err := t.proc(flatAPI_InitFlat); err == nil {
+		var msg steamErrMsg
+		v, err := steamAPI_InitFlat(unsafe.Pointer(&msg[0]))
+		if err != nil {
+			return initCallError(err)
+		}
+		return initFlatError(v, msg[:])
+	}
+
+	v, err := steamAPI_Init()
+	if err != nil {
+		return initCallError(err)
+	}
+	if v {
+		return nil
+	}
+
+	running, err := steamAPI_IsSteamRunning()
+	return initBoolError(err != nil || running)
+}
+
+// RunCallbacks dispatches the pending callbacks and call results to their handlers.
+// Handlers run on the calling goroutine, one at a time. RunCallbacks returns at once if
+// callbacks are already being dispatched, such as when a handler calls it.
+func RunCallbacks() {
+	if theDispatcher.isEnabled() {
+		if err := theDispatcher.runFrame(); err != nil {
+			panic(err)
+		}
+		return
+	}
+	if err := steamAPI_RunCallbacks(); err != nil {
+		panic(err)
+	}
+}
+
+func SteamAPI_RunCallbacks() {
+	RunCallbacks()
+}
+
+func RestartAppIfNecessary(appID uint32) bool {
+	v, err := steamAPI_RestartAppIfNecessary(appID)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// SteamAPI_ReleaseCurrentThreadMemory frees the memory the Steam API allocated for the
+// current OS thread. It does nothing if the library cannot be loaded.
+func SteamAPI_ReleaseCurrentThreadMemory() {
+	steamAPI_ReleaseCurrentThreadMemory()
+}
+
+func SteamApps() ISteamApps {
+	v, err := flatIface(flatAPI_SteamApps)
+	if err != nil {
+		panic(err)
+	}
+	return steamApps(v)
+}
+
+type steamApps uintptr
+
+func (s steamApps) BIsSubscribed() bool {
+	v, err := steamAPI_ISteamApps_BIsSubscribed(uintptr(s))
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (s steamApps) BIsSubscribedApp(appID AppId_t) bool {
+	v, err := steamAPI_ISteamApps_BIsSubscribedApp(uintptr(s), appID)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (s steamApps) BIsLowViolence() bool {
+	v, err := steamAPI_ISteamApps_BIsLowViolence(uintptr(s))
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (s steamApps) BIsVACBanned() bool {
+	v, err := steamAPI_ISteamApps_BIsVACBanned(uintptr(s))
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (s steamApps) BIsSubscribedFromFreeWeekend() bool {
+	v, err := steamAPI_ISteamApps_BIsSubscribedFromFreeWeekend(uintptr(s))
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (s steamApps) BIsSubscribedFromFamilySharing() bool {
+	v, err := steamAPI_ISteamApps_BIsSubscribedFromFamilySharing(uintptr(s))
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (s steamApps) GetAppOwner() CSteamID {
+	v, err := steamAPI_ISteamApps_GetAppOwner(uintptr(s))
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (s steamApps) GetEarliestPurchaseUnixTime(appID AppId_t) uint32 {
+	v, err := steamAPI_ISteamApps_GetEarliestPurchaseUnixTime(uintptr(s), appID)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (s steamApps) GetAppBuildId() int32 {
+	v, err := steamAPI_ISteamApps_GetAppBuildId(uintptr(s))
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (s steamApps) GetCurrentBetaName() (name string, ok bool) {
+	var buf [256]byte
+	v, err := steamAPI_ISteamApps_GetCurrentBetaName(uintptr(s), unsafe.Pointer(&buf[0]), int32(len(buf)))
+	if err != nil {
+		panic(err)
+	}
+	if !v {
+		return "", false
+	}
+	return goStringBytes(buf[:]), true
+}
+
+func (s steamApps) GetDLCCount() int32 {
+	v, err := steamAPI_ISteamApps_GetDLCCount(uintptr(s))
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (s steamApps) BGetDLCDataByIndex(iDLC int32) (appID AppId_t, available bool, name string, success bool) {
+	var buf [128]byte
+	v, err := steamAPI_ISteamApps_BGetDLCDataByIndex(uintptr(s), iDLC, unsafe.Pointer(&appID), unsafe.Pointer(&available), unsafe.Pointer(&buf[0]), int32(len(buf)))
+	if err != nil {
+		panic(err)
+	}
+	if !v {
+		return 0, false, "", false
+	}
+	return appID, available, goStringBytes(buf[:]), true
+}
+
+func (s steamApps) BIsDlcInstalled(appID AppId_t) bool {
+	v, err := steamAPI_ISteamApps_BIsDlcInstalled(uintptr(s), appID)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (s steamApps) InstallDLC(appID AppId_t) {
+	if err := steamAPI_ISteamApps_InstallDLC(uintptr(s), appID); err != nil {
+		panic(err)
+	}
+}
+
+func (s steamApps) UninstallDLC(appID AppId_t) {
+	if err := steamAPI_ISteamApps_UninstallDLC(uintptr(s), appID); err != nil {
+		panic(err)
+	}
+}
+
+func (s steamApps) GetDlcDownloadProgress(appID AppId_t) (bytesDownloaded, bytesTotal uint64, downloading bool) {
+	v, err := steamAPI_ISteamApps_GetDlcDownloadProgress(uintptr(s), appID, unsafe.Pointer(&bytesDownloaded), unsafe.Pointer(&bytesTotal))
+	if err != nil {
+		panic(err)
+	}
+	if !v {
+		return 0, 0, false
+	}
+	return bytesDownloaded, bytesTotal, true
+}
+
+func (s steamApps) BIsAppInstalled(appID AppId_t) bool {
+	v, err := steamAPI_ISteamApps_BIsAppInstalled(uintptr(s), appID)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (s steamApps) GetInstalledDepots(appID AppId_t) []DepotId_t {
+	var depots [256]DepotId_t
+	v, err := steamAPI_ISteamApps_GetInstalledDepots(uintptr(s), appID, unsafe.Pointer(&depots[0]), uint32(len(depots)))
+	if err != nil {
+		panic(err)
+	}
+	return append([]DepotId_t(nil), depots[:v]...)
+}
+
+func (s steamApps) GetFileDetails(fileName string) *CallResult[FileDetailsResult_t] {
+	cfileName := append([]byte(fileName), 0)
+	defer runtime.KeepAlive(cfileName)
+
+	v, err := steamAPI_ISteamApps_GetFileDetails(uintptr(s), unsafe.Pointer(&cfileName[0]))
+	if err != nil {
+		panic(err)
+	}
+	return newCallResult[FileDetailsResult_t](v)
+}
+
+func (s steamApps) MarkContentCorrupt(missingFilesOnly bool) bool {
+	v, err := steamAPI_ISteamApps_MarkContentCorrupt(uintptr(s), missingFilesOnly)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (s steamApps) GetAppInstallDir(appID AppId_t) string {
+	var path [4096]byte
+	v, err := steamAPI_ISteamApps_GetAppInstallDir(uintptr(s), appID, unsafe.Pointer(&path[0]), uint32(len(path)))
+	if err != nil {
+		panic(err)
+	}
+	return string(path[:v-1])
+}
+
+func (s steamApps) GetCurrentGameLanguage() string {
+	v, err := steamAPI_ISteamApps_GetCurrentGameLanguage(uintptr(s))
+	if err != nil {
+		panic(err)
+	}
+	return goString(v)
+}
+
+func (s steamApps) GetAvailableGameLanguages() string {
+	v, err := steamAPI_ISteamApps_GetAvailableGameLanguages(uintptr(s))
+	if err != nil {
+		panic(err)
+	}
+	return goString(v)
+}
+
+func (s steamApps) GetLaunchQueryParam(key string) string {
+	ckey := append([]byte(key), 0)
+	defer runtime.KeepAlive(ckey)
+
+	v, err := steamAPI_ISteamApps_GetLaunchQueryParam(uintptr(s), unsafe.Pointer(&ckey[0]))
+	if err != nil {
+		panic(err)
+	}
+	return goString(v)
+}
+
+func (s steamApps) GetLaunchCommandLine() string {
+	var buf [4096]byte
+	v, err := steamAPI_ISteamApps_GetLaunchCommandLine(uintptr(s), unsafe.Pointer(&buf[0]), int32(len(buf)))
+	if err != nil {
+		panic(err)
+	}
+	if v <= 0 {
+		return ""
+	}
+	return goStringBytes(buf[:v])
+}
+
+func SteamInput() ISteamInput {
+	v, err := flatIface(flatAPI_SteamInput)
+	if err != nil {
+		panic(err)
+	}
+	return steamInput(v)
+}
+
+type steamInput uintptr
+
+func (s steamInput) GetConnectedControllers() []InputHandle_t {
+	var handles [_STEAM_INPUT_MAX_COUNT]InputHandle_t
+	v, err := steamAPI_ISteamInput_GetConnectedControllers(uintptr(s), unsafe.Pointer(&handles[0]))
+	if err != nil {
+		panic(err)
+	}
+	return handles[:v]
+}
+
+func (s steamInput) GetInputTypeForHandle(inputHandle InputHandle_t) ESteamInputType {
+	v, err := steamAPI_ISteamInput_GetInputTypeForHandle(uintptr(s), inputHandle)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (s steamInput) Init(bExplicitlyCallRunFrame bool) bool {
+	v, err := steamAPI_ISteamInput_Init(uintptr(s), bExplicitlyCallRunFrame)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (s steamInput) RunFrame() {
+	if err := steamAPI_ISteamInput_RunFrame(uintptr(s), false); err != nil {
+		panic(err)
+	}
+}
+
+func SteamMatchmaking() ISteamMatchmaking {
+	v, err := flatIface(flatAPI_SteamMatchmaking)
+	if err != nil {
+		panic(err)
+	}
+	return steamMatchmaking(v)
+}
+
+type steamMatchmaking uintptr
+
+func (s steamMatchmaking) CreateLobby(eLobbyType ELobbyType, cMaxMembers int32) *CallResult[LobbyCreated_t] {
+	v, err := steamAPI_ISteamMatchmaking_CreateLobby(uintptr(s), eLobbyType, cMaxMembers)
+	if err != nil {
+		panic(err)
+	}
+	return newCallResult[LobbyCreated_t](v)
+}
+
+func (s steamMatchmaking) RequestLobbyList() *CallResult[LobbyMatchList_t] {
+	v, err := steamAPI_ISteamMatchmaking_RequestLobbyList(uintptr(s))
+	if err != nil {
+		panic(err)
+	}
+	return newCallResult[LobbyMatchList_t](v)
+}
+
+func (s steamMatchmaking) LeaveLobby(steamIDLobby CSteamID) {
+	if err := steamAPI_ISteamMatchmaking_LeaveLobby(uintptr(s), steamIDLobby); err != nil {
+		panic(err)
+	}
+}
+
+func (s steamMatchmaking) GetLobbyByIndex(iLobby int32) CSteamID {
+	v, err := steamAPI_ISteamMatchmaking_GetLobbyByIndex(uintptr(s), iLobby)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func SteamNetworkingMessages() ISteamNetworkingMessages {
+	v, err := flatIface(flatAPI_SteamNetworkingMessages)
+	if err != nil {
+		panic(err)
+	}
+	return steamNetworkingMessages(v)
+}
+
+type steamNetworkingMessages uintptr
+
+func (s steamNetworkingMessages) SendMessageToUser(identity SteamNetworkingIdentity, data []byte, sendFlags int32, channel int32) EResult {
+	defer runtime.KeepAlive(data)
+
+	v, err := steamAPI_ISteamNetworkingMessages_SendMessageToUser(uintptr(s), unsafe.Pointer(&identity), unsafe.Pointer(&data[0]), uint32(len(data)), sendFlags, channel)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// ReceiveMessagesOnChannel returns up to maxMessages messages received on the channel.
+// The payloads are copied and the messages are released before it returns.
+func (s steamNetworkingMessages) ReceiveMessagesOnChannel(localChannel int32, maxMessages int32) []NetworkingMessage {
+	if maxMessages <= 0 {
+		return nil
+	}
+	ptrs := s.receive(localChannel, make([]uintptr, maxMessages))
+	defer releaseMessages(ptrs)
+
+	msgs := make([]NetworkingMessage, len(ptrs))
+	for i, p := range ptrs {
+		msgs[i] = newNetworkingMessage(p, true)
+	}
+	return msgs
+}
+
+// BorrowMessagesOnChannel is like ReceiveMessagesOnChannel, but does not copy the
+// payloads. The messages are kept in pool until pool.Release is called.
+func (s steamNetworkingMessages) BorrowMessagesOnChannel(localChannel int32, maxMessages int32, pool *MessagePool) []NetworkingMessage {
+	if maxMessages <= 0 {
+		return nil
+	}
+	return pool.borrow(s.receive(localChannel, pool.buffer(int(maxMessages))))
+}
+
+// receive fills ptrs with pointers to the received SteamNetworkingMessage_t, which is an
+// array of pointers in C, and returns the filled part.
+func (s steamNetworkingMessages) receive(localChannel int32, ptrs []uintptr) []uintptr {
+	n, err := steamAPI_ISteamNetworkingMessages_ReceiveMessagesOnChannel(uintptr(s), localChannel, unsafe.Pointer(&ptrs[0]), int32(len(ptrs)))
+	if err != nil {
+		panic(err)
+	}
+	return ptrs[:n]
+}
+
+func (s steamNetworkingMessages) AcceptSessionWithUser(identityRemote SteamNetworkingIdentity) bool {
+	v, err := steamAPI_ISteamNetworkingMessages_AcceptSessionWithUser(uintptr(s), unsafe.Pointer(&identityRemote))
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (s steamNetworkingMessages) CloseSessionWithUser(identityRemote SteamNetworkingIdentity) bool {
+	v, err := steamAPI_ISteamNetworkingMessages_CloseSessionWithUser(uintptr(s), unsafe.Pointer(&identityRemote))
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (s steamNetworkingMessages) CloseChannelWithUser(identityRemote SteamNetworkingIdentity, nLocalChannel int32) bool {
+	v, err := steamAPI_ISteamNetworkingMessages_CloseChannelWithUser(uintptr(s), unsafe.Pointer(&identityRemote), nLocalChannel)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (s steamNetworkingMessages) GetSessionConnectionInfo(identityRemote SteamNetworkingIdentity) (ESteamNetworkingConnectionState, SteamNetConnectionInfo_t, SteamNetConnectionRealTimeStatus_t) {
+	info := SteamNetConnectionInfo_t{}
+	stats := SteamNetConnectionRealTimeStatus_t{}
+
+	v, err := steamAPI_ISteamNetworkingMessages_GetSessionConnectionInfo(uintptr(s), unsafe.Pointer(&identityRemote), unsafe.Pointer(&info), unsafe.Pointer(&stats))
+	if err != nil {
+		panic(err)
+	}
+	return v, info, stats
+}
+
+func SteamRemoteStorage() ISteamRemoteStorage {
+	v, err := flatIface(flatAPI_SteamRemoteStorage)
+	if err != nil {
+		panic(err)
+	}
+	return steamRemoteStorage(v)
+}
+
+type steamRemoteStorage uintptr
+
+func (s steamRemoteStorage) FileWrite(file string, data []byte) bool {
+	cfile := append([]byte(file), 0)
+	defer runtime.KeepAlive(cfile)
+
+	defer runtime.KeepAlive(data)
+
+	v, err := steamAPI_ISteamRemoteStorage_FileWrite(uintptr(s), unsafe.Pointer(&cfile[0]), unsafe.Pointer(&data[0]), int32(len(data)))
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (s steamRemoteStorage) FileRead(file string, data []byte) int32 {
+	cfile := append([]byte(file), 0)
+	defer runtime.KeepAlive(cfile)
+
+	defer runtime.KeepAlive(data)
+
+	v, err := steamAPI_ISteamRemoteStorage_FileRead(uintptr(s), unsafe.Pointer(&cfile[0]), unsafe.Pointer(&data[0]), int32(len(data)))
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (s steamRemoteStorage) FileDelete(file string) bool {
+	cfile := append([]byte(file), 0)
+	defer runtime.KeepAlive(cfile)
+
+	v, err := steamAPI_ISteamRemoteStorage_FileDelete(uintptr(s), unsafe.Pointer(&cfile[0]))
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (s steamRemoteStorage) GetFileSize(file string) int32 {
+	cfile := append([]byte(file), 0)
+	defer runtime.KeepAlive(cfile)
+
+	v, err := steamAPI_ISteamRemoteStorage_GetFileSize(uintptr(s), unsafe.Pointer(&cfile[0]))
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func SteamUser() ISteamUser {
+	v, err := flatIface(flatAPI_SteamUser)
+	if err != nil {
+		panic(err)
+	}
+	return steamUser(v)
+}
+
+type steamUser uintptr
+
+func (s steamUser) GetSteamID() CSteamID {
+	v, err := steamAPI_ISteamUser_GetSteamID(uintptr(s))
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func SteamUserStats() ISteamUserStats {
+	v, err := flatIface(flatAPI_SteamUserStats)
+	if err != nil {
+		panic(err)
+	}
+	return steamUserStats(v)
+}
+
+type steamUserStats uintptr
+
+func (s steamUserStats) RequestCurrentStats() bool {
+	v, err := steamAPI_ISteamUserStats_RequestCurrentStats(uintptr(s))
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (s steamUserStats) GetAchievement(name string) (achieved, success bool) {
+	cname := append([]byte(name), 0)
+	defer runtime.KeepAlive(cname)
+
+	v, err := steamAPI_ISteamUserStats_GetAchievement(uintptr(s), unsafe.Pointer(&cname[0]), unsafe.Pointer(&achieved))
+	if err != nil {
+		panic(err)
+	}
+	success = v
+
+	return
+}
+
+func (s steamUserStats) SetAchievement(name string) bool {
+	cname := append([]byte(name), 0)
+	defer runtime.KeepAlive(cname)
+
+	v, err := steamAPI_ISteamUserStats_SetAchievement(uintptr(s), unsafe.Pointer(&cname[0]))
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (s steamUserStats) ClearAchievement(name string) bool {
+	cname := append([]byte(name), 0)
+	defer runtime.KeepAlive(cname)
+
+	v, err := steamAPI_ISteamUserStats_ClearAchievement(uintptr(s), unsafe.Pointer(&cname[0]))
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (s steamUserStats) StoreStats() bool {
+	v, err := steamAPI_ISteamUserStats_StoreStats(uintptr(s))
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func SteamUtils() ISteamUtils {
+	v, err := flatIface(flatAPI_SteamUtils)
+	if err != nil {
+		panic(err)
+	}
+	return steamUtils(v)
+}
+
+type steamUtils uintptr
+
+func (s steamUtils) IsSteamRunningOnSteamDeck() bool {
+	v, err := steamAPI_ISteamUtils_IsSteamRunningOnSteamDeck(uintptr(s))
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (s steamUtils) GetAPICallFailureReason(call SteamAPICallbackHandle) ESteamAPICallFailure {
+	v, err := steamAPI_ISteamUtils_GetAPICallFailureReason(uintptr(s), call)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (s steamUtils) GetSteamUILanguage() string {
+	v, err := steamAPI_ISteamUtils_GetSteamUILanguage(uintptr(s))
+	if err != nil {
+		panic(err)
+	}
+	return goString(v)
+}
+
+// releaseMessages releases the SteamNetworkingMessage_t at ptrs.
+func releaseMessages(ptrs []uintptr) {
+	for _, p := range ptrs {
+		if err := steamAPI_SteamNetworkingMessage_t_Release(p); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func getHSteamPipe() (HSteamPipe, error) {
+	return steamAPI_GetHSteamPipe()
+}
+
+func manualDispatchInit() error {
+	return steamAPI_ManualDispatch_Init()
+}
+
+func manualDispatchRunFrame(pipe HSteamPipe) error {
+	return steamAPI_ManualDispatch_RunFrame(pipe)
+}
+
+func manualDispatchGetNextCallback(pipe HSteamPipe, msg *CallbackMsg_t) (bool, error) {
+	return steamAPI_ManualDispatch_GetNextCallback(pipe, unsafe.Pointer(msg))
+}
+
+func manualDispatchFreeLastCallback(pipe HSteamPipe) error {
+	return steamAPI_ManualDispatch_FreeLastCallback(pipe)
+}
+
+func manualDispatchGetAPICallResult(pipe HSteamPipe, call SteamAPICallbackHandle, data []byte, callbackID int32) (ok, failed bool, err error) {
+	ok, err = steamAPI_ManualDispatch_GetAPICallResult(pipe, call, unsafe.Pointer(&data[0]), int32(len(data)), callbackID, unsafe.Pointer(&failed))
+	return ok, failed, err
+}
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// steamLanguages are the language codes of the Steam API and their BCP 47 tags, as
+// listed in the Steamworks documentation.
+var steamLanguages = []struct {
+	code string
+	tag  language.Tag
+}{
+	{"english", language.MustParse("en")},
+	{"arabic", language.MustParse("ar")},
+	{"bulgarian", language.MustParse("bg")},
+	{"schinese", language.MustParse("zh-CN")},
+	{"tchinese", language.MustParse("zh-TW")},
+	{"czech", language.MustParse("cs")},
+	{"danish", language.MustParse("da")},
+	{"dutch", language.MustParse("nl")},
+	{"finnish", language.MustParse("fi")},
+	{"french", language.MustParse("fr")},
+	{"german", language.MustParse("de")},
+	{"greek", language.MustParse("el")},
+	{"hungarian", language.MustParse("hu")},
+	{"indonesian", language.MustParse("id")},
+	{"italian", language.MustParse("it")},
+	{"japanese", language.MustParse("ja")},
+	{"koreana", language.MustParse("ko")},
+	{"norwegian", language.MustParse("nb")},
+	{"polish", language.MustParse("pl")},
+	{"portuguese", language.MustParse("pt-PT")},
+	{"brazilian", language.MustParse("pt-BR")},
+	{"romanian", language.MustParse("ro")},
+	{"russian", language.MustParse("ru")},
+	{"spanish", language.MustParse("es-ES")},
+	{"latam", language.MustParse("es-419")},
+	{"swedish", language.MustParse("sv")},
+	{"thai", language.MustParse("th")},
+	{"turkish", language.MustParse("tr")},
+	{"ukrainian", language.MustParse("uk")},
+	{"vietnamese", language.MustParse("vi")},
+}
+
+// steamLanguageMatcher matches tags to the tags of steamLanguages, in the same order.
+var steamLanguageMatcher = func() language.Matcher {
+	tags := make([]language.Tag, len(steamLanguages))
+	for i, l := range steamLanguages {
+		tags[i] = l.tag
+	}
+	return language.NewMatcher(tags)
+}()
+
+// LanguageTag returns the BCP 47 tag of a Steam API language code, such as zh-CN for
+// schinese. ok is false if the code is unknown.
+func LanguageTag(steamLanguage string) (tag language.Tag, ok bool) {
+	for _, l := range steamLanguages {
+		if l.code == steamLanguage {
+			return l.tag, true
+		}
+	}
+	return language.Und, false
+}
+
+// SteamLanguage returns the Steam API language code closest to tag, such as latam for
+// es-MX or tchinese for zh-HK, or an empty string if no language is close enough.
+func SteamLanguage(tag language.Tag) string {
+	_, i, c := steamLanguageMatcher.Match(tag)
+	if c == language.No {
+		return ""
+	}
+	return steamLanguages[i].code
+}
+
+// PreferredLanguages returns the languages the app is available in, as reported by
+// GetAvailableGameLanguages, ordered by the preference of the user: the language chosen
+// for the game, then the language closest to the language of the Steam client, then
+// the other languages in the order Steam lists them.
+func PreferredLanguages() []language.Tag {
+	apps := SteamApps()
+	return preferredLanguages(apps.GetCurrentGameLanguage(), SteamUtils().GetSteamUILanguage(), apps.GetAvailableGameLanguages())
+}
+
+// preferredLanguages orders the comma-separated Steam language codes of available by
+// the codes current and ui.
+func preferredLanguages(current, ui, available string) []language.Tag {
+	var codes []string
+	for _, c := range strings.Split(available, ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			codes = append(codes, c)
+		}
+	}
+	if current != "" && !containsString(codes, current) {
+		codes = append([]string{current}, codes...)
+	}
+
+	var tags []language.Tag
+	for _, c := range codes {
+		if t, ok := LanguageTag(c); ok {
+			tags = append(tags, t)
+		}
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+
+	var preferred []language.Tag
+	if t, ok := LanguageTag(current); ok {
+		preferred = append(preferred, t)
+	}
+	if t, ok := LanguageTag(ui); ok {
+		_, i, c := language.NewMatcher(tags).Match(t)
+		if c != language.No {
+			preferred = append(preferred, tags[i])
+		}
+	}
+	preferred = append(preferred, tags...)
+
+	// Remove duplicates, keeping the first occurrence.
+	seen := map[language.Tag]bool{}
+	result := preferred[:0]
+	for _, t := range preferred {
+		if seen[t] {
+			continue
+		}
+		seen[t] = true
+		result = append(result, t)
+	}
+	return result
+}
+
+func containsString(ss []string, s string) bool {
+	for _, x := range ss {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"reflect"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestLanguageTag(t *testing.T) {
+	tests := []struct {
+		code   string
+		want   string
+		wantOK bool
+	}{
+		{"english", "en", true},
+		{"schinese", "zh-CN", true},
+		{"tchinese", "zh-TW", true},
+		{"koreana", "ko", true},
+		{"portuguese", "pt-PT", true},
+		{"brazilian", "pt-BR", true},
+		{"spanish", "es-ES", true},
+		{"latam", "es-419", true},
+		{"klingon", "und", false},
+		{"", "und", false},
+		{"English", "und", false},
+	}
+	for _, tt := range tests {
+		tag, ok := LanguageTag(tt.code)
+		if tag.String() != tt.want || ok != tt.wantOK {
+			t.Errorf("LanguageTag(%q) = %v, %v; want %v, %v", tt.code, tag, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestSteamLanguage(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want string
+	}{
+		{"en", "english"},
+		{"en-GB", "english"},
+		{"zh-CN", "schinese"},
+		{"zh-Hans", "schinese"},
+		{"zh-TW", "tchinese"},
+		{"zh-HK", "tchinese"},
+		{"zh-Hant", "tchinese"},
+		{"ko", "koreana"},
+		{"ko-KR", "koreana"},
+		{"pt-BR", "brazilian"},
+		{"pt-PT", "portuguese"},
+		{"pt", "brazilian"},
+		{"es-ES", "spanish"},
+		{"es-419", "latam"},
+		{"es-MX", "latam"},
+		{"es-AR", "latam"},
+		{"und", ""},
+		{"he", ""},
+		{"hr", ""},
+	}
+	for _, tt := range tests {
+		if got := SteamLanguage(language.MustParse(tt.tag)); got != tt.want {
+			t.Errorf("SteamLanguage(%s) = %q; want %q", tt.tag, got, tt.want)
+		}
+	}
+}
+
+// TestLanguageRoundTrip checks that every Steam language code maps back to itself.
+func TestLanguageRoundTrip(t *testing.T) {
+	for _, l := range steamLanguages {
+		tag, ok := LanguageTag(l.code)
+		if !ok {
+			t.Errorf("LanguageTag(%q) not found", l.code)
+			continue
+		}
+		if got := SteamLanguage(tag); got != l.code {
+			t.Errorf("SteamLanguage(LanguageTag(%q)) = %q", l.code, got)
+		}
+	}
+}
+
+func TestPreferredLanguages(t *testing.T) {
+	tests := []struct {
+		name      string
+		current   string
+		ui        string
+		available string
+		want      []string
+	}{
+		{
+			name:      "current first",
+			current:   "german",
+			ui:        "english",
+			available: "english,french,german",
+			want:      []string{"de", "en", "fr"},
+		},
+		{
+			name:      "ui matched to an available language",
+			current:   "english",
+			ui:        "latam",
+			available: "english,french,spanish",
+			want:      []string{"en", "es-ES", "fr"},
+		},
+		{
+			name:      "ui matched to a regional variant",
+			current:   "english",
+			ui:        "tchinese",
+			available: "english,schinese,tchinese",
+			want:      []string{"en", "zh-TW", "zh-CN"},
+		},
+		{
+			name:      "current not available",
+			current:   "japanese",
+			ui:        "japanese",
+			available: "english,french",
+			want:      []string{"ja", "en", "fr"},
+		},
+		{
+			name:      "duplicates and spaces",
+			current:   "french",
+			ui:        "french",
+			available: " english, french ,english,,french",
+			want:      []string{"fr", "en"},
+		},
+		{
+			name:      "unknown codes skipped",
+			current:   "klingon",
+			ui:        "klingon",
+			available: "klingon,english,koreana",
+			want:      []string{"en", "ko"},
+		},
+		{
+			name:      "ui without a close language",
+			current:   "",
+			ui:        "thai",
+			available: "english,german",
+			want:      []string{"en", "de"},
+		},
+		{
+			name:      "nothing available",
+			current:   "",
+			ui:        "english",
+			available: "",
+			want:      nil,
+		},
+		{
+			name:      "only unknown codes",
+			current:   "klingon",
+			ui:        "english",
+			available: "klingon",
+			want:      nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, tag := range preferredLanguages(tt.current, tt.ui, tt.available) {
+				got = append(got, tag.String())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("preferredLanguages(%q, %q, %q) = %v; want %v", tt.current, tt.ui, tt.available, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"context"
+	"net/url"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// NewUrlLaunchParameters_t is posted when the running app is launched again with new
+// parameters, for example from a steam://run/<appid>//?param=value link. Newer SDKs
+// renamed NewLaunchQueryParameters_t, the name api.gen.h still uses, to it.
+type NewUrlLaunchParameters_t = NewLaunchQueryParameters_t
+
+// CallbackID_NewUrlLaunchParameters_t is the callback ID of NewUrlLaunchParameters_t.
+const CallbackID_NewUrlLaunchParameters_t = CallbackID_NewLaunchQueryParameters_t
+
+// LaunchParameters are the parameters Steam launched the app with.
+type LaunchParameters struct {
+	// Args is the command line of GetLaunchCommandLine split into arguments.
+	Args []string
+
+	// Query holds the non-empty values of GetLaunchQueryParam for the requested keys.
+	Query url.Values
+}
+
+// ReadLaunchParameters returns the current launch parameters of the app. The query
+// parameters of a steam://run link cannot be enumerated, so only the values of keys are
+// read.
+func ReadLaunchParameters(apps ISteamApps, keys ...string) LaunchParameters {
+	p := LaunchParameters{
+		Args:  SplitCommandLine(apps.GetLaunchCommandLine()),
+		Query: url.Values{},
+	}
+	for _, key := range keys {
+		if v := apps.GetLaunchQueryParam(key); v != "" {
+			p.Query.Set(key, v)
+		}
+	}
+	return p
+}
+
+// WatchLaunchParameters returns a channel that receives the launch parameters each time
+// Steam posts NewUrlLaunchParameters_t, such as when the user follows a steam://run link
+// or accepts a join invitation while the app runs. The parameters are read as
+// ReadLaunchParameters does. Only the latest parameters are kept if the receiver falls
+// behind. The channel is closed once ctx is done or Shutdown is called.
+//
+// The events are delivered during RunCallbacks.
+func WatchLaunchParameters(ctx context.Context, apps ISteamApps, keys ...string) <-chan LaunchParameters {
+	ch := make(chan LaunchParameters, 1)
+
+	var (
+		mu      sync.Mutex
+		closed  bool
+		stopped = make(chan struct{})
+		once    sync.Once
+	)
+	sub := Subscribe(func(*NewUrlLaunchParameters_t) {
+		p := ReadLaunchParameters(apps, keys...)
+
+		mu.Lock()
+		defer mu.Unlock()
+		if closed {
+			return
+		}
+		// Replace the parameters the receiver has not taken yet.
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- p
+	})
+
+	stop := func() {
+		once.Do(func() {
+			sub.Unsubscribe()
+
+			mu.Lock()
+			defer mu.Unlock()
+			closed = true
+			close(ch)
+			close(stopped)
+		})
+	}
+	remove := onShutdown(stop)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-stopped:
+		}
+		stop()
+		remove()
+	}()
+	return ch
+}
+
+// SplitCommandLine splits a command line into arguments at unquoted white space, with
+// the quoting rules of the target platform.
+//
+// On Windows, it follows CommandLineToArgvW: double quotes group characters into one
+// argument, and backslashes are literal unless they precede a double quote, so that
+// paths such as C:\Games\x are kept as they are. 2n backslashes followed by a double
+// quote produce n backslashes and a quote that opens or closes a group, 2n+1 produce n
+// backslashes and a literal quote. Two double quotes in a group produce a literal quote.
+//
+// Elsewhere, double and single quotes group characters into one argument, and a
+// backslash outside single quotes escapes the next character, as in a POSIX shell.
+func SplitCommandLine(s string) []string {
+	if runtime.GOOS == "windows" {
+		return splitWindowsCommandLine(s)
+	}
+	return splitPOSIXCommandLine(s)
+}
+
+func isCommandLineSpace(c rune) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func splitPOSIXCommandLine(s string) []string {
+	var (
+		args    []string
+		arg     strings.Builder
+		inArg   bool
+		quote   rune
+		escaped bool
+	)
+	for _, c := range s {
+		switch {
+		case escaped:
+			arg.WriteRune(c)
+			escaped = false
+		case c == '\\' && quote != '\'':
+			escaped = true
+			inArg = true
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else {
+				arg.WriteRune(c)
+			}
+		case c == '"' || c == '\'':
+			quote = c
+			inArg = true
+		case isCommandLineSpace(c):
+			if inArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				inArg = false
+			}
+		default:
+			arg.WriteRune(c)
+			inArg = true
+		}
+	}
+	if inArg {
+		args = append(args, arg.String())
+	}
+	return args
+}
+
+func splitWindowsCommandLine(s string) []string {
+	var (
+		args        []string
+		arg         strings.Builder
+		inArg       bool
+		quoted      bool
+		backslashes int
+	)
+	for i, c := range s {
+		switch {
+		case c == '\\':
+			backslashes++
+			inArg = true
+		case c == '"':
+			arg.WriteString(strings.Repeat(`\`, backslashes/2))
+			switch {
+			case backslashes%2 == 1:
+				arg.WriteByte('"')
+			case quoted && i+1 < len(s) && s[i+1] == '"':
+				// A doubled quote in a group is a literal quote. The group is left
+				// here and reopened by the second quote.
+				arg.WriteByte('"')
+				quoted = false
+			default:
+				quoted = !quoted
+			}
+			backslashes = 0
+			inArg = true
+		default:
+			arg.WriteString(strings.Repeat(`\`, backslashes))
+			backslashes = 0
+			if !quoted && isCommandLineSpace(c) {
+				if inArg {
+					args = append(args, arg.String())
+					arg.Reset()
+					inArg = false
+				}
+				continue
+			}
+			arg.WriteRune(c)
+			inArg = true
+		}
+	}
+	arg.WriteString(strings.Repeat(`\`, backslashes))
+	if inArg {
+		args = append(args, arg.String())
+	}
+	return args
+}
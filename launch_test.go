@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSplitPOSIXCommandLine(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"", nil},
+		{"   ", nil},
+		{"-windowed -w 1280", []string{"-windowed", "-w", "1280"}},
+		{"  a\tb\nc  ", []string{"a", "b", "c"}},
+		{`+connect "my server" 'two words'`, []string{"+connect", "my server", "two words"}},
+		{`"" ''`, []string{"", ""}},
+		{`a\ b`, []string{"a b"}},
+		{`"a \"quoted\" word"`, []string{`a "quoted" word`}},
+		{`'a \ b'`, []string{`a \ b`}},
+		{`x"y z"w`, []string{"xy zw"}},
+		{`-name "Jürgen"`, []string{"-name", "Jürgen"}},
+	}
+	for _, tt := range tests {
+		if got := splitPOSIXCommandLine(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("splitPOSIXCommandLine(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestSplitWindowsCommandLine(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"", nil},
+		{"-windowed -w 1280", []string{"-windowed", "-w", "1280"}},
+		{`-path C:\Games\x`, []string{"-path", `C:\Games\x`}},
+		{`-path "C:\Program Files\Game\"`, []string{"-path", `C:\Program Files\Game"`}},
+		{`-path "C:\Program Files\Game\\"`, []string{"-path", `C:\Program Files\Game\`}},
+		{`\\server\share`, []string{`\\server\share`}},
+		{`a\\\"b`, []string{`a\"b`}},
+		{`a\\\\"b c" d`, []string{`a\\b c`, "d"}},
+		{`"a ""quoted"" word"`, []string{`a "quoted" word`}},
+		{`"" x`, []string{"", "x"}},
+		{`'two words'`, []string{"'two", "words'"}},
+		{`end\`, []string{`end\`}},
+	}
+	for _, tt := range tests {
+		if got := splitWindowsCommandLine(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("splitWindowsCommandLine(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func shutdownHookCount() int {
+	shutdownMu.Lock()
+	defer shutdownMu.Unlock()
+	return len(shutdownHooks)
+}
+
+func subscriberCount(callbackID int32) int {
+	theDispatcher.mu.Lock()
+	defer theDispatcher.mu.Unlock()
+	return len(theDispatcher.callbacks[callbackID])
+}
+
+// waitFor polls cond until it holds or a deadline passes.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestWatchLaunchParametersShutdown(t *testing.T) {
+	hooks := shutdownHookCount()
+	subs := subscriberCount(CallbackID_NewUrlLaunchParameters_t)
+
+	ch := WatchLaunchParameters(context.Background(), nil)
+	if n := subscriberCount(CallbackID_NewUrlLaunchParameters_t); n != subs+1 {
+		t.Fatalf("%d subscribers after WatchLaunchParameters, want %d", n, subs+1)
+	}
+
+	runShutdownHooks()
+
+	select {
+	case _, ok := <-ch:
+		if ok {
+			t.Error("received launch parameters, want the channel closed")
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("channel still open after Shutdown")
+	}
+	if n := subscriberCount(CallbackID_NewUrlLaunchParameters_t); n != subs {
+		t.Errorf("%d subscribers after Shutdown, want %d", n, subs)
+	}
+	waitFor(t, "the shutdown hook to be removed", func() bool {
+		return shutdownHookCount() == hooks
+	})
+}
+
+func TestWatchLaunchParametersCancel(t *testing.T) {
+	hooks := shutdownHookCount()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := WatchLaunchParameters(ctx, nil)
+	cancel()
+
+	select {
+	case _, ok := <-ch:
+		if ok {
+			t.Error("received launch parameters, want the channel closed")
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("channel still open after ctx was cancelled")
+	}
+	waitFor(t, "the shutdown hook to be removed", func() bool {
+		return shutdownHookCount() == hooks
+	})
+}
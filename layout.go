@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"sync"
+	"unsafe"
+)
+
+// Callback structs are packed to 8 bytes on Windows and to 4 bytes elsewhere, so their
+// C layout differs from the Go layout of the same fields. A cLayout maps each field of a
+// Go struct to its offset in the C struct, so that payloads can be copied field by field.
+
+type cField struct {
+	goOffset uintptr
+	cOffset  uintptr
+	size     uintptr
+}
+
+type cLayout struct {
+	size    uintptr
+	align   uintptr
+	fields  []cField
+	offsets []uintptr // C offsets of the top-level fields of a struct
+}
+
+// expectedLayout is the size and the field offsets of a struct as measured in C.
+type expectedLayout struct {
+	size    uintptr
+	offsets []uintptr
+}
+
+// callbackPack returns the packing of the callback structs on the target platform.
+func callbackPack() uintptr {
+	if runtime.GOOS == "windows" {
+		return 8
+	}
+	return 4
+}
+
+var (
+	layouts       sync.Map // reflect.Type -> *cLayout
+	layoutPkgPath = reflect.TypeOf(cLayout{}).PkgPath()
+)
+
+// layoutOf returns the C layout of the struct type t.
+func layoutOf(t reflect.Type) (*cLayout, error) {
+	if l, ok := layouts.Load(t); ok {
+		return l.(*cLayout), nil
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("steamworks: %s is not a struct", t)
+	}
+	pack := callbackPack()
+	l, err := computeLayout(t, pack)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkLayout(t, l, pack); err != nil {
+		return nil, err
+	}
+	layouts.Store(t, l)
+	return l, nil
+}
+
+func computeLayout(t reflect.Type, pack uintptr) (*cLayout, error) {
+	min := func(a, b uintptr) uintptr {
+		if a < b {
+			return a
+		}
+		return b
+	}
+	scalar := func(size, align uintptr) *cLayout {
+		return &cLayout{
+			size:   size,
+			align:  align,
+			fields: []cField{{size: size}},
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool, reflect.Int8, reflect.Uint8:
+		return scalar(1, 1), nil
+	case reflect.Int16, reflect.Uint16:
+		return scalar(2, 2), nil
+	case reflect.Int32, reflect.Uint32, reflect.Float32:
+		return scalar(4, 4), nil
+	case reflect.Int64, reflect.Uint64, reflect.Float64:
+		return scalar(8, min(8, pack)), nil
+	case reflect.Uintptr, reflect.Ptr, reflect.UnsafePointer:
+		return scalar(t.Size(), min(t.Size(), pack)), nil
+
+	case reflect.Array:
+		elem, err := computeLayout(t.Elem(), pack)
+		if err != nil {
+			return nil, err
+		}
+		l := &cLayout{size: elem.size * uintptr(t.Len()), align: elem.align}
+		for i := 0; i < t.Len(); i++ {
+			for _, f := range elem.fields {
+				l.fields = append(l.fields, cField{
+					goOffset: uintptr(i)*t.Elem().Size() + f.goOffset,
+					cOffset:  uintptr(i)*elem.size + f.cOffset,
+					size:     f.size,
+				})
+			}
+		}
+		return l, nil
+
+	case reflect.Struct:
+		// An empty struct takes a byte in C++.
+		if t.NumField() == 0 {
+			return &cLayout{size: 1, align: 1}, nil
+		}
+		l := &cLayout{align: 1}
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			fl, err := computeLayout(sf.Type, pack)
+			if err != nil {
+				return nil, fmt.Errorf("steamworks: field %s.%s: %w", t, sf.Name, err)
+			}
+			align := min(fl.align, pack)
+			offset := (l.size + align - 1) &^ (align - 1)
+			for _, f := range fl.fields {
+				l.fields = append(l.fields, cField{
+					goOffset: sf.Offset + f.goOffset,
+					cOffset:  offset + f.cOffset,
+					size:     f.size,
+				})
+			}
+			l.offsets = append(l.offsets, offset)
+			l.size = offset + fl.size
+			if align > l.align {
+				l.align = align
+			}
+		}
+		l.size = (l.size + l.align - 1) &^ (l.align - 1)
+		return l, nil
+	}
+
+	return nil, fmt.Errorf("type %s has no fixed C layout", t)
+}
+
+// checkLayout compares the layout computed with the packing pack for a struct of this
+// package with the one measured in api.gen.h, so that a Go definition diverging from the
+// C header is caught before any payload is misread.
+func checkLayout(t reflect.Type, l *cLayout, pack uintptr) error {
+	if t.PkgPath() != layoutPkgPath {
+		return nil
+	}
+	e, ok := expectedLayoutOf(t.Name(), pack)
+	if !ok {
+		return nil
+	}
+	if l.size != e.size {
+		return fmt.Errorf("steamworks: %s is %d bytes in C but laid out as %d", t, e.size, l.size)
+	}
+	if len(l.offsets) != len(e.offsets) {
+		return fmt.Errorf("steamworks: %s has %d fields in C but %d in Go", t, len(e.offsets), len(l.offsets))
+	}
+	for i, o := range e.offsets {
+		if l.offsets[i] != o {
+			return fmt.Errorf("steamworks: field %s.%s is at offset %d in C but laid out at %d", t, t.Field(i).Name, o, l.offsets[i])
+		}
+	}
+	return nil
+}
+
+// expectedLayoutOf returns the layout measured for the struct name, packed to pack bytes,
+// on targets with the pointer size of this one.
+func expectedLayoutOf(name string, pack uintptr) (expectedLayout, bool) {
+	want, ok := expectedLayouts[name]
+	if !ok {
+		return expectedLayout{}, false
+	}
+	i := 0
+	if pack == 8 {
+		i = 1
+	}
+	if unsafe.Sizeof(uintptr(0)) == 4 {
+		i += 2
+	}
+	return want[i], true
+}
+
+// decode copies the C struct in data to the Go struct at p.
+func (l *cLayout) decode(p unsafe.Pointer, data []byte) error {
+	if uintptr(len(data)) != l.size {
+		return fmt.Errorf("steamworks: callback payload is %d bytes, want %d", len(data), l.size)
+	}
+	dst := unsafe.Slice((*byte)(p), l.goSize())
+	for _, f := range l.fields {
+		copy(dst[f.goOffset:f.goOffset+f.size], data[f.cOffset:f.cOffset+f.size])
+	}
+	return nil
+}
+
+// goSize returns the number of bytes of the Go struct that the fields cover.
+func (l *cLayout) goSize() uintptr {
+	var n uintptr
+	for _, f := range l.fields {
+		if end := f.goOffset + f.size; end > n {
+			n = end
+		}
+	}
+	return n
+}
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestGeneratedLayouts checks every struct generated from api.gen.h against the layouts
+// measured in C with both packings. Run it with GOARCH=386 as well to check the 32-bit
+// layouts.
+func TestGeneratedLayouts(t *testing.T) {
+	if len(generatedStructs) != len(expectedLayouts) {
+		t.Errorf("%d generated structs but %d expected layouts", len(generatedStructs), len(expectedLayouts))
+	}
+	for _, v := range generatedStructs {
+		typ := reflect.TypeOf(v)
+		if _, ok := expectedLayouts[typ.Name()]; !ok {
+			t.Errorf("%s: no expected layout", typ)
+			continue
+		}
+		for _, pack := range []uintptr{4, 8} {
+			l, err := computeLayout(typ, pack)
+			if err != nil {
+				t.Errorf("pack %d: %v", pack, err)
+				continue
+			}
+			if err := checkLayout(typ, l, pack); err != nil {
+				t.Errorf("pack %d: %v", pack, err)
+			}
+		}
+	}
+}
+
+func TestLayoutOfChecksGeneratedStructs(t *testing.T) {
+	for _, v := range generatedStructs {
+		if _, err := layoutOf(reflect.TypeOf(v)); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestCheckLayoutMismatch(t *testing.T) {
+	type LobbyEnter_t struct {
+		SteamIDLobby CSteamID
+		Permissions  uint32
+	}
+	typ := reflect.TypeOf(LobbyEnter_t{})
+	l, err := computeLayout(typ, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := checkLayout(typ, l, 4); err == nil {
+		t.Error("checkLayout accepted a struct with missing fields")
+	}
+}
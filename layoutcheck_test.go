@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+//go:build linux && cgo && !nosteam
+
+package steamworks
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+// mirroredLayout is an offset or a size of a struct passed to and from the Steam API as
+// it is, unlike callbacks, whose payloads are converted by layoutOf. expr is the C
+// expression of the value in the SDK headers, and goValue its counterpart in Go. Go may
+// add up to slack bytes of trailing padding to a struct; offsets must match exactly.
+type mirroredLayout struct {
+	expr    string
+	goValue uintptr
+	slack   uintptr
+}
+
+func offsetOf(expr string, goValue uintptr) mirroredLayout {
+	return mirroredLayout{expr: expr, goValue: goValue, slack: 1}
+}
+
+func sizeOf(expr string, goValue, goAlign uintptr) mirroredLayout {
+	return mirroredLayout{expr: expr, goValue: goValue, slack: goAlign}
+}
+
+var mirroredLayouts = []mirroredLayout{
+	offsetOf("offsetof(CallbackMsg_t, m_hSteamUser)", unsafe.Offsetof(CallbackMsg_t{}.m_hSteamUser)),
+	offsetOf("offsetof(CallbackMsg_t, m_iCallback)", unsafe.Offsetof(CallbackMsg_t{}.m_iCallback)),
+	offsetOf("offsetof(CallbackMsg_t, m_pubParam)", unsafe.Offsetof(CallbackMsg_t{}.m_pubParam)),
+	offsetOf("offsetof(CallbackMsg_t, m_cubParam)", unsafe.Offsetof(CallbackMsg_t{}.m_cubParam)),
+	sizeOf("sizeof(CallbackMsg_t)", unsafe.Sizeof(CallbackMsg_t{}), unsafe.Alignof(CallbackMsg_t{})),
+
+	offsetOf("offsetof(SteamNetworkingIPAddr, m_ipv6)", unsafe.Offsetof(SteamNetworkingIPAddr{}.IPv6)),
+	offsetOf("offsetof(SteamNetworkingIPAddr, m_port)", unsafe.Offsetof(SteamNetworkingIPAddr{}.Port)),
+	sizeOf("sizeof(SteamNetworkingIPAddr)", unsafe.Sizeof(SteamNetworkingIPAddr{}), 1),
+
+	offsetOf("offsetof(SteamNetworkingIdentity, m_eType)", unsafe.Offsetof(SteamNetworkingIdentity{}.EType)),
+	offsetOf("offsetof(SteamNetworkingIdentity, m_cbSize)", unsafe.Offsetof(SteamNetworkingIdentity{}.Size)),
+	offsetOf("offsetof(SteamNetworkingIdentity, m_steamID64)", unsafe.Offsetof(SteamNetworkingIdentity{}.data)),
+	sizeOf("sizeof(SteamNetworkingIdentity::m_szUnknownRawString)", unsafe.Sizeof(SteamNetworkingIdentity{}.data), 1),
+	sizeOf("sizeof(SteamNetworkingIdentity)", unsafe.Sizeof(SteamNetworkingIdentity{}), 1),
+
+	offsetOf("offsetof(SteamNetConnectionInfo_t, m_identityRemote)", unsafe.Offsetof(SteamNetConnectionInfo_t{}.IdentityRemote)),
+	offsetOf("offsetof(SteamNetConnectionInfo_t, m_nUserData)", unsafe.Offsetof(SteamNetConnectionInfo_t{}.UserData)),
+	offsetOf("offsetof(SteamNetConnectionInfo_t, m_hListenSocket)", unsafe.Offsetof(SteamNetConnectionInfo_t{}.ListenSocket)),
+	offsetOf("offsetof(SteamNetConnectionInfo_t, m_addrRemote)", unsafe.Offsetof(SteamNetConnectionInfo_t{}.AddrRemote)),
+	offsetOf("offsetof(SteamNetConnectionInfo_t, m__pad1)", unsafe.Offsetof(SteamNetConnectionInfo_t{}.pad1)),
+	offsetOf("offsetof(SteamNetConnectionInfo_t, m_idPOPRemote)", unsafe.Offsetof(SteamNetConnectionInfo_t{}.IdPOPRemote)),
+	offsetOf("offsetof(SteamNetConnectionInfo_t, m_idPOPRelay)", unsafe.Offsetof(SteamNetConnectionInfo_t{}.IdPOPRelay)),
+	offsetOf("offsetof(SteamNetConnectionInfo_t, m_eState)", unsafe.Offsetof(SteamNetConnectionInfo_t{}.State)),
+	offsetOf("offsetof(SteamNetConnectionInfo_t, m_eEndReason)", unsafe.Offsetof(SteamNetConnectionInfo_t{}.EndReason)),
+	offsetOf("offsetof(SteamNetConnectionInfo_t, m_szEndDebug)", unsafe.Offsetof(SteamNetConnectionInfo_t{}.EndDebug)),
+	offsetOf("offsetof(SteamNetConnectionInfo_t, m_szConnectionDescription)", unsafe.Offsetof(SteamNetConnectionInfo_t{}.ConnectionDescription)),
+	offsetOf("offsetof(SteamNetConnectionInfo_t, m_nFlags)", unsafe.Offsetof(SteamNetConnectionInfo_t{}.Flags)),
+	offsetOf("offsetof(SteamNetConnectionInfo_t, reserved)", unsafe.Offsetof(SteamNetConnectionInfo_t{}.reserved)),
+	sizeOf("sizeof(SteamNetConnectionInfo_t)", unsafe.Sizeof(SteamNetConnectionInfo_t{}), unsafe.Alignof(SteamNetConnectionInfo_t{})),
+
+	offsetOf("offsetof(SteamNetConnectionRealTimeStatus_t, m_eState)", unsafe.Offsetof(SteamNetConnectionRealTimeStatus_t{}.State)),
+	offsetOf("offsetof(SteamNetConnectionRealTimeStatus_t, m_nPing)", unsafe.Offsetof(SteamNetConnectionRealTimeStatus_t{}.Ping)),
+	offsetOf("offsetof(SteamNetConnectionRealTimeStatus_t, m_flConnectionQualityLocal)", unsafe.Offsetof(SteamNetConnectionRealTimeStatus_t{}.ConnectionQualityLocal)),
+	offsetOf("offsetof(SteamNetConnectionRealTimeStatus_t, m_flConnectionQualityRemote)", unsafe.Offsetof(SteamNetConnectionRealTimeStatus_t{}.ConnectionQualityRemote)),
+	offsetOf("offsetof(SteamNetConnectionRealTimeStatus_t, m_flOutPacketsPerSec)", unsafe.Offsetof(SteamNetConnectionRealTimeStatus_t{}.OutPacketsPerSec)),
+	offsetOf("offsetof(SteamNetConnectionRealTimeStatus_t, m_flOutBytesPerSec)", unsafe.Offsetof(SteamNetConnectionRealTimeStatus_t{}.OutBytesPerSec)),
+	offsetOf("offsetof(SteamNetConnectionRealTimeStatus_t, m_flInPacketsPerSec)", unsafe.Offsetof(SteamNetConnectionRealTimeStatus_t{}.InPacketsPerSec)),
+	offsetOf("offsetof(SteamNetConnectionRealTimeStatus_t, m_flInBytesPerSec)", unsafe.Offsetof(SteamNetConnectionRealTimeStatus_t{}.InBytesPerSec)),
+	offsetOf("offsetof(SteamNetConnectionRealTimeStatus_t, m_nSendRateBytesPerSecond)", unsafe.Offsetof(SteamNetConnectionRealTimeStatus_t{}.SendRateBytesPerSecond)),
+	offsetOf("offsetof(SteamNetConnectionRealTimeStatus_t, m_cbPendingUnreliable)", unsafe.Offsetof(SteamNetConnectionRealTimeStatus_t{}.PendingUnreliable)),
+	offsetOf("offsetof(SteamNetConnectionRealTimeStatus_t, m_cbPendingReliable)", unsafe.Offsetof(SteamNetConnectionRealTimeStatus_t{}.PendingReliable)),
+	offsetOf("offsetof(SteamNetConnectionRealTimeStatus_t, m_cbSentUnackedReliable)", unsafe.Offsetof(SteamNetConnectionRealTimeStatus_t{}.SentUnackedReliable)),
+	offsetOf("offsetof(SteamNetConnectionRealTimeStatus_t, m_usecQueueTime)", unsafe.Offsetof(SteamNetConnectionRealTimeStatus_t{}.QueueTime)),
+	offsetOf("offsetof(SteamNetConnectionRealTimeStatus_t, reserved)", unsafe.Offsetof(SteamNetConnectionRealTimeStatus_t{}.reserved)),
+	sizeOf("sizeof(SteamNetConnectionRealTimeStatus_t)", unsafe.Sizeof(SteamNetConnectionRealTimeStatus_t{}), unsafe.Alignof(SteamNetConnectionRealTimeStatus_t{})),
+
+	offsetOf("offsetof(SteamNetworkingMessage_t, m_pData)", unsafe.Offsetof(SteamNetworkingMessage_t{}.Data)),
+	offsetOf("offsetof(SteamNetworkingMessage_t, m_cbSize)", unsafe.Offsetof(SteamNetworkingMessage_t{}.Size)),
+	offsetOf("offsetof(SteamNetworkingMessage_t, m_conn)", unsafe.Offsetof(SteamNetworkingMessage_t{}.Connection)),
+	offsetOf("offsetof(SteamNetworkingMessage_t, m_identityPeer)", unsafe.Offsetof(SteamNetworkingMessage_t{}.PeerIdentity)),
+	offsetOf("offsetof(SteamNetworkingMessage_t, m_nConnUserData)", unsafe.Offsetof(SteamNetworkingMessage_t{}.ConnUserData)),
+	offsetOf("offsetof(SteamNetworkingMessage_t, m_usecTimeReceived)", unsafe.Offsetof(SteamNetworkingMessage_t{}.TimeReceived)),
+	offsetOf("offsetof(SteamNetworkingMessage_t, m_nMessageNumber)", unsafe.Offsetof(SteamNetworkingMessage_t{}.MessageNumber)),
+	offsetOf("offsetof(SteamNetworkingMessage_t, m_pfnFreeData)", unsafe.Offsetof(SteamNetworkingMessage_t{}.FreeData)),
+	offsetOf("offsetof(SteamNetworkingMessage_t, m_pfnRelease)", unsafe.Offsetof(SteamNetworkingMessage_t{}.Release)),
+	offsetOf("offsetof(SteamNetworkingMessage_t, m_nChannel)", unsafe.Offsetof(SteamNetworkingMessage_t{}.Channel)),
+	offsetOf("offsetof(SteamNetworkingMessage_t, m_nFlags)", unsafe.Offsetof(SteamNetworkingMessage_t{}.Flags)),
+	offsetOf("offsetof(SteamNetworkingMessage_t, m_nUserData)", unsafe.Offsetof(SteamNetworkingMessage_t{}.UserData)),
+	offsetOf("offsetof(SteamNetworkingMessage_t, m_idxLane)", unsafe.Offsetof(SteamNetworkingMessage_t{}.LaneIdx)),
+	offsetOf("offsetof(SteamNetworkingMessage_t, _pad1__)", unsafe.Offsetof(SteamNetworkingMessage_t{}.Padding)),
+	sizeOf("sizeof(SteamNetworkingMessage_t)", unsafe.Sizeof(SteamNetworkingMessage_t{}), unsafe.Alignof(SteamNetworkingMessage_t{})),
+}
+
+// TestMirroredLayouts compiles a program that prints mirroredLayouts as measured in the
+// SDK headers, with the C++ compiler and flags cgo builds the package with, and compares
+// them with the Go structs.
+func TestMirroredLayouts(t *testing.T) {
+	env, err := exec.Command(filepath.Join(runtime.GOROOT(), "bin", "go"), "env", "CXX", "GOGCCFLAGS", "CGO_CPPFLAGS", "CGO_CXXFLAGS").Output()
+	if err != nil {
+		t.Fatalf("go env: %v", err)
+	}
+	vars := strings.Split(string(env), "\n")
+	cxx := strings.Fields(vars[0])
+	if len(cxx) == 0 {
+		t.Skip("no C++ compiler")
+	}
+	if _, err := exec.LookPath(cxx[0]); err != nil {
+		t.Skipf("C++ compiler %s not found", cxx[0])
+	}
+
+	var src strings.Builder
+	src.WriteString("#include \"shim.h\"\n#include <cstddef>\n#include <cstdio>\n#include <steam/steam_api.h>\n\nint main() {\n")
+	for _, l := range mirroredLayouts {
+		fmt.Fprintf(&src, "\tstd::printf(\"%%zu\\n\", (size_t)(%s));\n", l.expr)
+	}
+	src.WriteString("\treturn 0;\n}\n")
+
+	dir := t.TempDir()
+	srcPath := filepath.Join(dir, "layout.cpp")
+	binPath := filepath.Join(dir, "layout")
+	if err := os.WriteFile(srcPath, []byte(src.String()), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	args := append([]string{}, cxx[1:]...)
+	for _, v := range vars[1:] {
+		args = append(args, strings.Fields(v)...)
+	}
+	args = append(args, "-Wno-invalid-offsetof", "-I", wd, "-o", binPath, srcPath)
+	if out, err := exec.Command(cxx[0], args...).CombinedOutput(); err != nil {
+		t.Fatalf("compiling the SDK headers: %v\n%s", err, out)
+	}
+	out, err := exec.Command(binPath).Output()
+	if err != nil {
+		t.Fatalf("running the layout program: %v", err)
+	}
+
+	values := strings.Fields(string(out))
+	if len(values) != len(mirroredLayouts) {
+		t.Fatalf("layout program printed %d values, want %d", len(values), len(mirroredLayouts))
+	}
+	for i, l := range mirroredLayouts {
+		c, err := strconv.ParseUint(values[i], 10, 64)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if l.goValue < uintptr(c) || l.goValue-uintptr(c) >= l.slack {
+			t.Errorf("%s is %d in C but %d in Go", l.expr, c, l.goValue)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+)
+
+// LoadOptions configures how Load finds the Steam API library.
+type LoadOptions struct {
+	// Path is the path of the Steam API library to load.
+	//
+	// If Path is empty, the library named by the STEAMWORKS_LIB environment variable is
+	// loaded. Failing that, a library next to the executable is used if there is one,
+	// and then the copy embedded in this package, if any.
+	Path string
+
+	// CacheDir is the directory the embedded library is extracted to.
+	// The library is placed in a subdirectory named after its content hash, so
+	// the same file is reused across runs.
+	//
+	// If CacheDir is empty, a go-steamworks directory in os.UserCacheDir is used.
+	CacheDir string
+
+	// KeepExtracted keeps the library extracted to CacheDir when Shutdown unloads it,
+	// so that the next run reuses it instead of extracting it again. By default,
+	// Shutdown removes the file.
+	KeepExtracted bool
+
+	// ExtractToDisk makes Load always extract the embedded library to CacheDir.
+	//
+	// By default on Linux, the embedded library is loaded from an anonymous in-memory
+	// file, and it is extracted to disk only if that fails.
+	ExtractToDisk bool
+}
+
+// libEnv is the environment variable that names the Steam API library to load.
+const libEnv = "STEAMWORKS_LIB"
+
+var errAlreadyLoaded = errors.New("steamworks: the Steam API library is already loaded")
+
+// findLib returns the path of the Steam API library to load as configured by opts,
+// the environment or the executable's directory.
+// It returns an empty string when none is configured.
+func findLib(opts *LoadOptions) string {
+	if opts != nil && opts.Path != "" {
+		return opts.Path
+	}
+	if path := os.Getenv(libEnv); path != "" {
+		return path
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		return ""
+	}
+	path := filepath.Join(filepath.Dir(exe), libName())
+	if _, err := os.Stat(path); err != nil {
+		return ""
+	}
+	return path
+}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFindLib(t *testing.T) {
+	exe, err := os.Executable()
+	if err != nil {
+		t.Skipf("cannot locate the test executable: %v", err)
+	}
+	exeLib := filepath.Join(filepath.Dir(exe), libName())
+	if _, err := os.Stat(exeLib); err == nil {
+		t.Skipf("%s already exists", exeLib)
+	}
+
+	dir := t.TempDir()
+	explicit := filepath.Join(dir, "explicit", libName())
+	env := filepath.Join(dir, "env", libName())
+
+	tests := []struct {
+		name   string
+		opts   *LoadOptions
+		env    string
+		exeLib bool
+		want   string
+	}{
+		{"explicit path", &LoadOptions{Path: explicit}, env, true, explicit},
+		{"environment", &LoadOptions{}, env, true, env},
+		{"environment without options", nil, env, true, env},
+		{"executable directory", nil, "", true, exeLib},
+		{"embedded", nil, "", false, ""},
+		{"embedded with options", &LoadOptions{CacheDir: dir}, "", false, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(libEnv, tt.env)
+			if tt.exeLib {
+				if err := os.WriteFile(exeLib, nil, 0644); err != nil {
+					t.Skipf("cannot create a library next to the executable: %v", err)
+				}
+				defer os.Remove(exeLib)
+			}
+			if got := findLib(tt.opts); got != tt.want {
+				t.Errorf("findLib = %q; want %q", got, tt.want)
+			}
+		})
+	}
+}
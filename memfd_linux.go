@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+//go:build !nosteam
+
+package steamworks
+
+import (
+	"fmt"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// memfdLib writes the embedded library to an anonymous in-memory file and returns
+// a path to it that can be passed to dlopen. This works even where no writable and
+// executable directory is available, such as in a Flatpak sandbox.
+//
+// closeFile must be called once the library is opened.
+func memfdLib() (path string, closeFile func(), err error) {
+	fd, err := unix.MemfdCreate(libName(), unix.MFD_CLOEXEC)
+	if err != nil {
+		return "", nil, fmt.Errorf("steamworks: memfd_create failed: %w", err)
+	}
+	f := os.NewFile(uintptr(fd), libName())
+	if _, err := f.Write(libSteamAPI); err != nil {
+		f.Close()
+		return "", nil, err
+	}
+	return fmt.Sprintf("/proc/self/fd/%d", fd), func() { f.Close() }, nil
+}
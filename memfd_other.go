@@ -0,0 +1,14 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+//go:build !linux && !windows && !nosteam
+
+package steamworks
+
+import (
+	"errors"
+)
+
+func memfdLib() (path string, closeFile func(), err error) {
+	return "", nil, errors.New("steamworks: in-memory loading is not supported on this platform")
+}
@@ -0,0 +1,292 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// These are the limits of the data of an identity, as in steamnetworkingtypes.h.
+const (
+	maxGenericString   = 32 // including the terminating NUL
+	maxGenericBytes    = 32
+	maxXboxPairwiseID  = 33 // including the terminating NUL
+	maxUnknownRawIdent = 128
+)
+
+// IPAddrFromAddrPort returns the address and the port of ap. An IPv4 address is stored
+// in the IPv4-mapped form, as the Steam API does.
+func IPAddrFromAddrPort(ap netip.AddrPort) SteamNetworkingIPAddr {
+	return SteamNetworkingIPAddr{
+		IPv6: ap.Addr().As16(),
+		Port: ap.Port(),
+	}
+}
+
+// AddrPort returns the address and the port. An IPv4-mapped address is returned as an
+// IPv4 address.
+func (a *SteamNetworkingIPAddr) AddrPort() netip.AddrPort {
+	return netip.AddrPortFrom(netip.AddrFrom16(a.IPv6).Unmap(), a.Port)
+}
+
+// IsIPv4 reports whether the address is an IPv4 address in the IPv4-mapped form.
+func (a *SteamNetworkingIPAddr) IsIPv4() bool {
+	return netip.AddrFrom16(a.IPv6).Is4In6()
+}
+
+// String returns the address and the port, such as 1.2.3.4:27015 or [::1]:27015, as
+// SteamNetworkingIPAddr::ToString does.
+func (a *SteamNetworkingIPAddr) String() string {
+	return a.AddrPort().String()
+}
+
+// ParseSteamNetworkingIPAddr parses an IPv4 or IPv6 address, optionally followed by a
+// port, such as 1.2.3.4:27015 or [::1]:27015. The port is 0 if it is omitted.
+func ParseSteamNetworkingIPAddr(s string) (SteamNetworkingIPAddr, error) {
+	ap, err := parseAddrPort(s)
+	if err != nil {
+		return SteamNetworkingIPAddr{}, fmt.Errorf("steamworks: invalid IP address %q: %w", s, err)
+	}
+	return IPAddrFromAddrPort(ap), nil
+}
+
+func parseAddrPort(s string) (netip.AddrPort, error) {
+	ap, err := netip.ParseAddrPort(s)
+	if err != nil {
+		addr, aerr := netip.ParseAddr(s)
+		if aerr != nil {
+			return netip.AddrPort{}, aerr
+		}
+		ap = netip.AddrPortFrom(addr, 0)
+	}
+	// SteamNetworkingIPAddr has no room for a zone, which would be dropped.
+	if ap.Addr().Zone() != "" {
+		return netip.AddrPort{}, errors.New("zones are not supported")
+	}
+	return ap, nil
+}
+
+// IdentityFromSteamID returns the identity of the Steam ID.
+func IdentityFromSteamID(id CSteamID) SteamNetworkingIdentity {
+	var i SteamNetworkingIdentity
+	i.SetSteamID64(uint64(id))
+	return i
+}
+
+// IdentityFromIP returns the identity of the IP address and the port.
+func IdentityFromIP(ap netip.AddrPort) SteamNetworkingIdentity {
+	i := SteamNetworkingIdentity{
+		EType: ESteamNetworkingIdentityType_IPAddress,
+		Size:  int32(unsafe.Sizeof(SteamNetworkingIPAddr{})),
+	}
+	addr := IPAddrFromAddrPort(ap)
+	copy(i.data[:16], addr.IPv6[:])
+	binary.LittleEndian.PutUint16(i.data[16:], addr.Port)
+	return i
+}
+
+// IdentityFromGenericString returns the identity of an application-defined string. The
+// string must be shorter than 32 bytes and must not contain NUL.
+func IdentityFromGenericString(s string) (SteamNetworkingIdentity, error) {
+	i, err := identityFromString(ESteamNetworkingIdentityType_GenericString, s, maxGenericString)
+	if err != nil {
+		return SteamNetworkingIdentity{}, fmt.Errorf("steamworks: invalid generic string %q: %w", s, err)
+	}
+	return i, nil
+}
+
+// IdentityFromGenericBytes returns the identity of application-defined bytes. At most
+// 32 bytes are allowed.
+func IdentityFromGenericBytes(b []byte) (SteamNetworkingIdentity, error) {
+	i, err := identityFromBytes(b)
+	if err != nil {
+		return SteamNetworkingIdentity{}, fmt.Errorf("steamworks: invalid generic bytes: %w", err)
+	}
+	return i, nil
+}
+
+func identityFromBytes(b []byte) (SteamNetworkingIdentity, error) {
+	if len(b) > maxGenericBytes {
+		return SteamNetworkingIdentity{}, fmt.Errorf("%d bytes long, want at most %d", len(b), maxGenericBytes)
+	}
+	i := SteamNetworkingIdentity{
+		EType: ESteamNetworkingIdentityType_GenericBytes,
+		Size:  int32(len(b)),
+	}
+	copy(i.data[:], b)
+	return i, nil
+}
+
+func identityFromString(t ESteamNetworkingIdentityType, s string, max int) (SteamNetworkingIdentity, error) {
+	if len(s) >= max {
+		return SteamNetworkingIdentity{}, fmt.Errorf("%d bytes long, want less than %d", len(s), max)
+	}
+	if strings.IndexByte(s, 0) >= 0 {
+		return SteamNetworkingIdentity{}, errors.New("contains NUL")
+	}
+	i := SteamNetworkingIdentity{
+		EType: t,
+		Size:  int32(len(s) + 1),
+	}
+	copy(i.data[:], s)
+	return i, nil
+}
+
+// IsInvalid reports whether the identity is empty.
+func (i *SteamNetworkingIdentity) IsInvalid() bool {
+	return i.EType == ESteamNetworkingIdentityType_Invalid
+}
+
+// SteamID returns the Steam ID of the identity, or 0 if it is not a Steam ID.
+func (i *SteamNetworkingIdentity) SteamID() CSteamID {
+	return CSteamID(i.SteamID64())
+}
+
+// IP returns the IP address and the port of the identity. ok is false if the identity
+// is not an IP address.
+func (i *SteamNetworkingIdentity) IP() (ap netip.AddrPort, ok bool) {
+	if i.EType != ESteamNetworkingIdentityType_IPAddress {
+		return netip.AddrPort{}, false
+	}
+	addr := SteamNetworkingIPAddr{Port: binary.LittleEndian.Uint16(i.data[16:])}
+	copy(addr.IPv6[:], i.data[:16])
+	return addr.AddrPort(), true
+}
+
+// GenericString returns the application-defined string of the identity. ok is false if
+// the identity is not a generic string.
+func (i *SteamNetworkingIdentity) GenericString() (s string, ok bool) {
+	if i.EType != ESteamNetworkingIdentityType_GenericString {
+		return "", false
+	}
+	return i.cString(), true
+}
+
+// GenericBytes returns the application-defined bytes of the identity. ok is false if the
+// identity is not generic bytes.
+func (i *SteamNetworkingIdentity) GenericBytes() (b []byte, ok bool) {
+	if i.EType != ESteamNetworkingIdentityType_GenericBytes || i.Size < 0 || i.Size > maxGenericBytes {
+		return nil, false
+	}
+	return append([]byte(nil), i.data[:i.Size]...), true
+}
+
+func (i *SteamNetworkingIdentity) cString() string {
+	return goStringBytes(i.data[:])
+}
+
+// String returns the identity in the textual form of SteamNetworkingIdentity::ToString,
+// such as steamid:76561197960287930, ip:1.2.3.4:27015, str:name or gen:0a0b.
+func (i *SteamNetworkingIdentity) String() string {
+	switch i.EType {
+	case ESteamNetworkingIdentityType_Invalid:
+		return "invalid"
+	case ESteamNetworkingIdentityType_SteamID:
+		return "steamid:" + strconv.FormatUint(i.SteamID64(), 10)
+	case ESteamNetworkingIdentityType_IPAddress:
+		ap, _ := i.IP()
+		return "ip:" + ap.String()
+	case ESteamNetworkingIdentityType_GenericString:
+		return "str:" + i.cString()
+	case ESteamNetworkingIdentityType_GenericBytes:
+		b, _ := i.GenericBytes()
+		return "gen:" + hex.EncodeToString(b)
+	case ESteamNetworkingIdentityType_XboxPairwiseID:
+		return "xboxpairwise:" + i.cString()
+	case ESteamNetworkingIdentityType_SonyPSN:
+		return "psn:" + strconv.FormatUint(binary.LittleEndian.Uint64(i.data[:]), 10)
+	case ESteamNetworkingIdentityType_UnknownType:
+		return i.cString()
+	}
+	return fmt.Sprintf("invalid_type:%d", i.EType)
+}
+
+// ParseSteamNetworkingIdentity parses an identity in the textual form String returns.
+// Like SteamNetworkingIdentity::ParseString, it keeps a string with an unknown prefix,
+// such as foo:bar, as an identity of the unknown type.
+func ParseSteamNetworkingIdentity(s string) (SteamNetworkingIdentity, error) {
+	i, err := parseIdentity(s)
+	if err != nil {
+		return SteamNetworkingIdentity{}, fmt.Errorf("steamworks: invalid identity %q: %w", s, err)
+	}
+	return i, nil
+}
+
+func parseIdentity(s string) (SteamNetworkingIdentity, error) {
+	if s == "invalid" {
+		return SteamNetworkingIdentity{}, nil
+	}
+	prefix, value, ok := strings.Cut(s, ":")
+	if !ok {
+		return SteamNetworkingIdentity{}, errors.New("want type:value")
+	}
+	switch prefix {
+	case "steamid":
+		v, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return SteamNetworkingIdentity{}, err
+		}
+		return IdentityFromSteamID(CSteamID(v)), nil
+	case "ip":
+		ap, err := parseAddrPort(value)
+		if err != nil {
+			return SteamNetworkingIdentity{}, err
+		}
+		return IdentityFromIP(ap), nil
+	case "str":
+		return identityFromString(ESteamNetworkingIdentityType_GenericString, value, maxGenericString)
+	case "gen":
+		b, err := hex.DecodeString(value)
+		if err != nil {
+			return SteamNetworkingIdentity{}, err
+		}
+		return identityFromBytes(b)
+	case "xboxpairwise":
+		return identityFromString(ESteamNetworkingIdentityType_XboxPairwiseID, value, maxXboxPairwiseID)
+	case "psn":
+		v, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return SteamNetworkingIdentity{}, err
+		}
+		i := SteamNetworkingIdentity{
+			EType: ESteamNetworkingIdentityType_SonyPSN,
+			Size:  8,
+		}
+		binary.LittleEndian.PutUint64(i.data[:], v)
+		return i, nil
+	}
+
+	if prefix == "" {
+		return SteamNetworkingIdentity{}, errors.New("empty type")
+	}
+	for _, c := range prefix {
+		if !('a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '_') {
+			return SteamNetworkingIdentity{}, fmt.Errorf("invalid type %q", prefix)
+		}
+	}
+	return identityFromString(ESteamNetworkingIdentityType_UnknownType, s, maxUnknownRawIdent)
+}
+
+// MarshalText implements encoding.TextMarshaler. The identity is encoded in the form
+// String returns.
+func (i *SteamNetworkingIdentity) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (i *SteamNetworkingIdentity) UnmarshalText(text []byte) error {
+	v, err := ParseSteamNetworkingIdentity(string(text))
+	if err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
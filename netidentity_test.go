@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"net/netip"
+	"strings"
+	"testing"
+)
+
+func TestSteamNetworkingIdentityRoundTrip(t *testing.T) {
+	for _, s := range []string{
+		"invalid",
+		"steamid:76561197960287930",
+		"ip:1.2.3.4:27015",
+		"ip:[::1]:27015",
+		"ip:[2001:db8::1]:0",
+		"str:player one",
+		"str:",
+		"gen:0a0b0c",
+		"gen:",
+		"xboxpairwise:abcdef",
+		"psn:1234567890",
+		"foo:bar",
+		"custom_type2:value:with:colons",
+	} {
+		i, err := ParseSteamNetworkingIdentity(s)
+		if err != nil {
+			t.Errorf("ParseSteamNetworkingIdentity(%q): %v", s, err)
+			continue
+		}
+		if got := i.String(); got != s {
+			t.Errorf("ParseSteamNetworkingIdentity(%q).String() = %q", s, got)
+		}
+
+		text, err := i.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got SteamNetworkingIdentity
+		if err := got.UnmarshalText(text); err != nil {
+			t.Errorf("UnmarshalText(%q): %v", text, err)
+		} else if got != i {
+			t.Errorf("UnmarshalText(%q) = %v, want %v", text, &got, &i)
+		}
+	}
+}
+
+func TestSteamNetworkingIdentityParse(t *testing.T) {
+	i, err := ParseSteamNetworkingIdentity("ip:1.2.3.4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ap, ok := i.IP(); !ok || ap != netip.MustParseAddrPort("1.2.3.4:0") {
+		t.Errorf("IP() = %v, %v; want 1.2.3.4:0, true", ap, ok)
+	}
+
+	i, err = ParseSteamNetworkingIdentity("steamid:76561197960287930")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id := i.SteamID(); id != NewSteamID(22202, SteamUserDesktopInstance, EAccountTypeIndividual, EUniversePublic) {
+		t.Errorf("SteamID() = %d", id)
+	}
+
+	i, err = ParseSteamNetworkingIdentity("gen:0a0b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b, ok := i.GenericBytes(); !ok || string(b) != "\x0a\x0b" {
+		t.Errorf("GenericBytes() = %x, %v; want 0a0b, true", b, ok)
+	}
+}
+
+func TestSteamNetworkingIdentityParseErrors(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"steamid",
+		":value",
+		"steamid:x",
+		"ip:1.2.3",
+		"ip:[fe80::1%eth0]:1",
+		"str:" + strings.Repeat("x", 32),
+		"str:a\x00b",
+		"gen:0g",
+		"gen:" + strings.Repeat("00", 33),
+		"xboxpairwise:" + strings.Repeat("x", 33),
+		"psn:-1",
+		"Foo:bar",
+		"foo-bar:baz",
+		"foo:" + strings.Repeat("x", 124),
+	} {
+		if i, err := ParseSteamNetworkingIdentity(s); err == nil {
+			t.Errorf("ParseSteamNetworkingIdentity(%q) = %v, want an error", s, &i)
+		}
+	}
+}
+
+func TestSteamNetworkingIPAddrRoundTrip(t *testing.T) {
+	for _, s := range []string{"1.2.3.4:27015", "[::1]:27015", "[2001:db8::1]:1", "0.0.0.0:0"} {
+		a, err := ParseSteamNetworkingIPAddr(s)
+		if err != nil {
+			t.Errorf("ParseSteamNetworkingIPAddr(%q): %v", s, err)
+			continue
+		}
+		if got := a.String(); got != s {
+			t.Errorf("ParseSteamNetworkingIPAddr(%q).String() = %q", s, got)
+		}
+	}
+
+	a, err := ParseSteamNetworkingIPAddr("1.2.3.4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !a.IsIPv4() || a.Port != 0 {
+		t.Errorf("ParseSteamNetworkingIPAddr(\"1.2.3.4\") = %v, want an IPv4 address with port 0", &a)
+	}
+}
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"unsafe"
+)
+
+// NetworkingMessage is a message received from a peer. Unlike SteamNetworkingMessage_t,
+// it is owned by Go: receiving it already released the message of the Steam API.
+type NetworkingMessage struct {
+	Data          []byte
+	Sender        SteamNetworkingIdentity
+	Channel       int32
+	Lane          uint16
+	TimeReceived  SteamNetworkingMicroseconds
+	MessageNumber int64
+	Flags         int32 // the k_nSteamNetworkingSend_* flags the message was sent with
+}
+
+// newNetworkingMessage reads the SteamNetworkingMessage_t at p. The payload is copied if
+// copyData is true, and refers to the memory of the Steam API otherwise.
+func newNetworkingMessage(p uintptr, copyData bool) NetworkingMessage {
+	// Convert through memory so that vet does not mistake p for a Go pointer.
+	m := *(**SteamNetworkingMessage_t)(unsafe.Pointer(&p))
+
+	var data []byte
+	if m.Data != nil && m.Size > 0 {
+		data = unsafe.Slice(m.Data, m.Size)
+		if copyData {
+			data = append([]byte(nil), data...)
+		}
+	}
+	return NetworkingMessage{
+		Data:          data,
+		Sender:        m.PeerIdentity,
+		Channel:       m.Channel,
+		Lane:          m.LaneIdx,
+		TimeReceived:  m.TimeReceived,
+		MessageNumber: m.MessageNumber,
+		Flags:         m.Flags,
+	}
+}
+
+// A MessagePool lends received messages without copying their payloads, for
+// ISteamNetworkingMessages.BorrowMessagesOnChannel. The Data of a borrowed message
+// refers to memory of the Steam API, which stays valid until Release is called. Release
+// the pool once the messages of a frame are handled; the pool can then be reused without
+// allocating.
+//
+// The zero value is an empty pool. A MessagePool must not be used by multiple goroutines
+// at the same time.
+type MessagePool struct {
+	native []uintptr // the SteamNetworkingMessage_t pointers not released yet
+	msgs   []NetworkingMessage
+
+	// release releases native messages; releaseMessages if nil.
+	release func(ptrs []uintptr)
+}
+
+// Len returns the number of messages borrowed since the last Release.
+func (p *MessagePool) Len() int {
+	return len(p.msgs)
+}
+
+// buffer returns room for n more SteamNetworkingMessage_t pointers.
+func (p *MessagePool) buffer(n int) []uintptr {
+	l := len(p.native)
+	if cap(p.native)-l < n {
+		native := make([]uintptr, l, l+n)
+		copy(native, p.native)
+		p.native = native
+	}
+	return p.native[l : l+n]
+}
+
+// borrow keeps the messages ptrs, which were received into the buffer, until Release.
+func (p *MessagePool) borrow(ptrs []uintptr) []NetworkingMessage {
+	p.native = p.native[:len(p.native)+len(ptrs)]
+	start := len(p.msgs)
+	for _, ptr := range ptrs {
+		p.msgs = append(p.msgs, newNetworkingMessage(ptr, false))
+	}
+	return p.msgs[start:len(p.msgs):len(p.msgs)]
+}
+
+// Release gives the borrowed messages back to the Steam API and clears them. The Data of
+// the messages must not be used afterwards. Releasing an empty pool does nothing.
+func (p *MessagePool) Release() {
+	release := p.release
+	if release == nil {
+		release = releaseMessages
+	}
+	release(p.native)
+	for i := range p.native {
+		p.native[i] = 0
+	}
+	p.native = p.native[:0]
+	for i := range p.msgs {
+		p.msgs[i] = NetworkingMessage{}
+	}
+	p.msgs = p.msgs[:0]
+}
@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"unsafe"
+)
+
+// fakeMessage returns a SteamNetworkingMessage_t carrying payload, and its address as
+// the Steam API would return it.
+func fakeMessage(payload []byte, channel int32) (*SteamNetworkingMessage_t, uintptr) {
+	m := &SteamNetworkingMessage_t{
+		Size:          int32(len(payload)),
+		TimeReceived:  1234,
+		MessageNumber: 42,
+		Channel:       channel,
+		Flags:         8,
+		LaneIdx:       1,
+	}
+	if len(payload) > 0 {
+		m.Data = &payload[0]
+	}
+	m.PeerIdentity = IdentityFromSteamID(76561197960287930)
+	return m, uintptr(unsafe.Pointer(m))
+}
+
+func TestNewNetworkingMessage(t *testing.T) {
+	payload := []byte("hello")
+	m, p := fakeMessage(payload, 3)
+
+	got := newNetworkingMessage(p, true)
+	want := NetworkingMessage{
+		Data:          []byte("hello"),
+		Sender:        m.PeerIdentity,
+		Channel:       3,
+		Lane:          1,
+		TimeReceived:  1234,
+		MessageNumber: 42,
+		Flags:         8,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("newNetworkingMessage = %+v; want %+v", got, want)
+	}
+
+	// A copied payload does not change with the memory of the Steam API.
+	payload[0] = 'j'
+	if string(got.Data) != "hello" {
+		t.Errorf("copied Data = %q after the payload changed; want %q", got.Data, "hello")
+	}
+
+	// A borrowed payload is the memory of the Steam API.
+	borrowed := newNetworkingMessage(p, false)
+	if &borrowed.Data[0] != &payload[0] {
+		t.Error("borrowed Data does not refer to the payload")
+	}
+}
+
+func TestNewNetworkingMessageEmpty(t *testing.T) {
+	_, p := fakeMessage(nil, 0)
+	for _, copyData := range []bool{true, false} {
+		if m := newNetworkingMessage(p, copyData); m.Data != nil {
+			t.Errorf("newNetworkingMessage(copyData %v).Data = %q; want nil", copyData, m.Data)
+		}
+	}
+}
+
+func TestMessagePool(t *testing.T) {
+	var released [][]uintptr
+	pool := &MessagePool{release: func(ptrs []uintptr) {
+		released = append(released, append([]uintptr(nil), ptrs...))
+	}}
+
+	payloads := [][]byte{[]byte("a"), []byte("bc"), []byte("def")}
+	var ptrs []uintptr
+	var msgs []NetworkingMessage
+	for i, payload := range payloads {
+		_, p := fakeMessage(payload, int32(i))
+		ptrs = append(ptrs, p)
+
+		// Receive one message at a time, so that the buffer grows.
+		buf := pool.buffer(4)
+		buf[0] = p
+		msgs = append(msgs, pool.borrow(buf[:1])...)
+	}
+	if pool.Len() != len(payloads) {
+		t.Fatalf("Len = %d; want %d", pool.Len(), len(payloads))
+	}
+	for i, m := range msgs {
+		if !bytes.Equal(m.Data, payloads[i]) || m.Channel != int32(i) {
+			t.Errorf("message %d = %q on channel %d; want %q on channel %d", i, m.Data, m.Channel, payloads[i], i)
+		}
+	}
+
+	// The borrowed payloads are the memory of the Steam API until Release.
+	payloads[2][0] = 'x'
+	if string(msgs[2].Data) != "xef" {
+		t.Errorf("borrowed Data = %q after the payload changed; want %q", msgs[2].Data, "xef")
+	}
+
+	pool.Release()
+	if len(released) != 1 || !reflect.DeepEqual(released[0], ptrs) {
+		t.Fatalf("released %v; want [%v]", released, ptrs)
+	}
+	if pool.Len() != 0 {
+		t.Errorf("Len = %d after Release; want 0", pool.Len())
+	}
+
+	// The borrowed messages are cleared, so that they no longer refer to the released
+	// memory.
+	_, p := fakeMessage([]byte("g"), 0)
+	buf := pool.buffer(1)
+	buf[0] = p
+	msgs = pool.borrow(buf)
+	pool.Release()
+	if msgs[0].Data != nil {
+		t.Errorf("borrowed Data = %q after Release; want nil", msgs[0].Data)
+	}
+
+	// Releasing again releases nothing.
+	released = nil
+	pool.Release()
+	pool.Release()
+	for _, ptrs := range released {
+		if len(ptrs) != 0 {
+			t.Errorf("Release of an empty pool released %v", ptrs)
+		}
+	}
+}
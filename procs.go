@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+// procTable holds the addresses of the flat API functions, resolved once when the
+// library is loaded. It is never modified afterwards, so it is safe for concurrent use.
+type procTable struct {
+	procs [flatAPICount]uintptr
+	names [flatAPICount]string
+
+	// versions holds the version bound for each interface accessor.
+	versions [flatAPICount]int
+
+	// refused holds the errors for methods that the bound interface version does not support.
+	refused [flatAPICount]error
+
+	missing []string
+}
+
+// resolveProcs looks up every flat API function with lookup, which returns 0 for a missing symbol.
+func resolveProcs(lookup func(name string) uintptr) *procTable {
+	t := &procTable{names: flatAPINames}
+	for id, name := range t.names {
+		if name != "" {
+			t.procs[id] = lookup(name)
+		}
+	}
+	for i := range steamInterfaces {
+		t.bind(&steamInterfaces[i], lookup)
+	}
+	for id, f := range t.procs {
+		if f == 0 && t.refused[id] == nil {
+			t.missing = append(t.missing, t.names[id])
+		}
+	}
+	return t
+}
+
+// bind looks up the newest known version of the accessor of i and refuses the methods
+// that version does not support.
+func (t *procTable) bind(i *steamInterface, lookup func(name string) uintptr) {
+	a := i.accessor
+	t.names[a] = i.symbol(i.versions[0])
+	for _, v := range i.versions {
+		if f := lookup(i.symbol(v)); f != 0 {
+			t.procs[a], t.names[a], t.versions[a] = f, i.symbol(v), v
+			break
+		}
+	}
+	if t.procs[a] == 0 {
+		return
+	}
+	for m, r := range i.methods {
+		if !r.contains(t.versions[a]) {
+			t.procs[m] = 0
+			t.refused[m] = &versionError{method: t.names[m], iface: i.name, version: t.versions[a]}
+		}
+	}
+}
+
+func (t *procTable) proc(id flatAPI) (uintptr, error) {
+	if f := t.procs[id]; f != 0 {
+		return f, nil
+	}
+	if err := t.refused[id]; err != nil {
+		return 0, err
+	}
+	return 0, &symbolError{name: t.names[id]}
+}
+
+// MissingSymbols loads the library if needed and returns the names of the flat API
+// functions used by this package that it does not export. Calls that need one of
+// them report an error instead of crashing.
+func MissingSymbols() ([]string, error) {
+	t, err := loadedProcs()
+	if err != nil {
+		return nil, err
+	}
+	return append([]string(nil), t.missing...), nil
+}
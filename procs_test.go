@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// benchmarkProcs returns a table in which every function resolves.
+func benchmarkProcs() *procTable {
+	return resolveProcs(func(name string) uintptr {
+		return 1
+	})
+}
+
+func BenchmarkProc(b *testing.B) {
+	t := benchmarkProcs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := t.proc(flatAPI_ISteamApps_BIsSubscribed); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkProcParallel looks functions up as the calls of the bindings do: the table is
+// loaded from an atomic.Value, without taking a lock, on every call.
+func BenchmarkProcParallel(b *testing.B) {
+	var table atomic.Value
+	table.Store(benchmarkProcs())
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			t := table.Load().(*procTable)
+			if _, err := t.proc(flatAPI_ISteamApps_BIsSubscribed); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+}
+
+// BenchmarkProcParallelMutex is BenchmarkProcParallel with the table guarded by a mutex
+// instead, for comparison.
+func BenchmarkProcParallelMutex(b *testing.B) {
+	var mu sync.Mutex
+	table := benchmarkProcs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			mu.Lock()
+			t := table
+			mu.Unlock()
+			if _, err := t.proc(flatAPI_ISteamApps_BIsSubscribed); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+}
+
+// BenchmarkProcByNameParallel is the baseline the table replaced: every call took the
+// loader mutex and looked its function up by name in a map, which was filled on the
+// first call of each function by dlsym, or by NewProc and Find on Windows. Only that
+// first call converted the name to a C string, so it is not measured here.
+func BenchmarkProcByNameParallel(b *testing.B) {
+	var mu sync.Mutex
+	procs := map[string]uintptr{}
+	for _, name := range flatAPINames {
+		procs[name] = 1
+	}
+	name := flatAPINames[flatAPI_ISteamApps_BIsSubscribed]
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			mu.Lock()
+			f := procs[name]
+			mu.Unlock()
+			if f == 0 {
+				b.Error("missing function")
+				return
+			}
+		}
+	})
+}
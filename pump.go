@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+var errPumpRunning = errors.New("steamworks: callback pump already running")
+
+// PumpStats reports how long the calls to RunCallbacks made by a CallbackPump took.
+type PumpStats struct {
+	// Ticks is the number of calls to RunCallbacks.
+	Ticks uint64
+
+	// Last is the duration of the most recent call.
+	Last time.Duration
+
+	// Max is the duration of the longest call.
+	Max time.Duration
+
+	// Total is the duration of all calls.
+	Total time.Duration
+
+	// Err is a *PumpPanicError if a call panicked, which stopped the pump.
+	Err error
+}
+
+// PumpPanicError reports a panic during a call to RunCallbacks made by a CallbackPump,
+// for example in a callback handler. The pump recovers the panic and stops.
+type PumpPanicError struct {
+	Value interface{} // the value passed to panic
+	Stack []byte      // the stack of the goroutine that panicked
+}
+
+func (e *PumpPanicError) Error() string {
+	return fmt.Sprintf("steamworks: callback pump stopped by a panic: %v", e.Value)
+}
+
+// CallbackPump dispatches Steam callbacks by calling RunCallbacks, either periodically
+// on its own goroutine or whenever the game calls its RunCallbacks method.
+type CallbackPump struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	mu    sync.Mutex
+	stats PumpStats
+
+	// runCallbacks dispatches the callbacks; SteamAPI_RunCallbacks if nil.
+	runCallbacks func()
+}
+
+var (
+	pumpMu sync.Mutex
+	pump   *CallbackPump
+)
+
+// StartCallbackPump starts dispatching Steam callbacks every interval on a goroutine
+// locked to an OS thread, until ctx is cancelled, Stop is called or Shutdown is called.
+//
+// If interval is 0, the pump runs in manual mode: it starts no goroutine and the game
+// calls the RunCallbacks method of the pump from its own frame loop instead.
+//
+// A panic during RunCallbacks, such as in a callback handler, stops the pump. It is
+// reported by Stats and passed to the handler set by SetCallbackErrorHandler.
+//
+// Only one pump can run at a time. No pump is started by Init.
+func StartCallbackPump(ctx context.Context, interval time.Duration) (*CallbackPump, error) {
+	if interval < 0 {
+		return nil, errors.New("steamworks: negative callback pump interval")
+	}
+	if err := available(); err != nil {
+		return nil, err
+	}
+
+	pumpMu.Lock()
+	defer pumpMu.Unlock()
+
+	if pump != nil {
+		select {
+		case <-pump.done:
+		default:
+			return nil, errPumpRunning
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	p := &CallbackPump{
+		ctx:    ctx,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	if interval > 0 {
+		go p.run(interval)
+	} else {
+		go func() {
+			<-ctx.Done()
+			close(p.done)
+		}()
+	}
+	pump = p
+	return p, nil
+}
+
+// stopCallbackPump stops the running pump, if any, and waits for it to exit.
+func stopCallbackPump() {
+	pumpMu.Lock()
+	p := pump
+	pump = nil
+	pumpMu.Unlock()
+
+	if p != nil {
+		p.Stop()
+	}
+}
+
+func (p *CallbackPump) run(interval time.Duration) {
+	defer close(p.done)
+
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		p.tick()
+
+		select {
+		case <-ticker.C:
+		case <-p.ctx.Done():
+			SteamAPI_ReleaseCurrentThreadMemory()
+			return
+		}
+	}
+}
+
+func (p *CallbackPump) tick() {
+	start := time.Now()
+	defer func() {
+		d := time.Since(start)
+		var err error
+		if v := recover(); v != nil {
+			err = &PumpPanicError{Value: v, Stack: debug.Stack()}
+		}
+
+		p.mu.Lock()
+		p.stats.Ticks++
+		p.stats.Last = d
+		p.stats.Total += d
+		if d > p.stats.Max {
+			p.stats.Max = d
+		}
+		if err != nil {
+			p.stats.Err = err
+		}
+		p.mu.Unlock()
+
+		if err != nil {
+			p.cancel()
+			reportCallbackError(err)
+		}
+	}()
+
+	if p.runCallbacks != nil {
+		p.runCallbacks()
+	} else {
+		SteamAPI_RunCallbacks()
+	}
+}
+
+// RunCallbacks dispatches the pending Steam callbacks and records how long it took.
+// It is meant for pumps in manual mode, and does nothing once the pump is stopped.
+func (p *CallbackPump) RunCallbacks() {
+	if p.ctx.Err() != nil {
+		return
+	}
+	p.tick()
+}
+
+// Stop stops the pump and waits for its goroutine to exit.
+func (p *CallbackPump) Stop() {
+	p.cancel()
+	<-p.done
+}
+
+// Done returns a channel that is closed once the pump has stopped.
+func (p *CallbackPump) Done() <-chan struct{} {
+	return p.done
+}
+
+// Stats returns the durations of the calls to RunCallbacks made so far, and the panic
+// that stopped the pump, if any.
+func (p *CallbackPump) Stats() PumpStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.stats
+}
@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// newTestPump returns a pump that calls runCallbacks instead of the Steam API.
+func newTestPump(runCallbacks func()) *CallbackPump {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &CallbackPump{
+		ctx:          ctx,
+		cancel:       cancel,
+		done:         make(chan struct{}),
+		runCallbacks: runCallbacks,
+	}
+}
+
+func TestCallbackPumpRecoversPanic(t *testing.T) {
+	defer SetCallbackErrorHandler(nil)
+
+	reported := make(chan error, 1)
+	SetCallbackErrorHandler(func(err error) {
+		reported <- err
+	})
+
+	ticks := 0
+	p := newTestPump(func() {
+		ticks++
+		if ticks == 3 {
+			panic("handler failed")
+		}
+	})
+	go p.run(time.Millisecond)
+
+	select {
+	case <-p.Done():
+	case <-time.After(10 * time.Second):
+		t.Fatal("pump still running after a panic")
+	}
+
+	stats := p.Stats()
+	if stats.Ticks != 3 {
+		t.Errorf("Ticks = %d; want 3", stats.Ticks)
+	}
+	var perr *PumpPanicError
+	if !errors.As(stats.Err, &perr) || perr.Value != "handler failed" || len(perr.Stack) == 0 {
+		t.Errorf("Stats().Err = %#v; want a *PumpPanicError with the panic value and a stack", stats.Err)
+	}
+	select {
+	case err := <-reported:
+		if err != stats.Err {
+			t.Errorf("error handler got %v; want %v", err, stats.Err)
+		}
+	default:
+		t.Error("error handler not called")
+	}
+}
+
+func TestCallbackPumpManualRecoversPanic(t *testing.T) {
+	calls := 0
+	p := newTestPump(func() {
+		calls++
+		panic(errors.New("handler failed"))
+	})
+	go func() {
+		<-p.ctx.Done()
+		close(p.done)
+	}()
+
+	p.RunCallbacks()
+	<-p.Done()
+	if p.Stats().Err == nil {
+		t.Error("Stats().Err = nil after a panic")
+	}
+
+	// A stopped pump dispatches nothing.
+	p.RunCallbacks()
+	if calls != 1 {
+		t.Errorf("callbacks run %d times; want 1", calls)
+	}
+}
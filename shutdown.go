@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"runtime"
+	"sync"
+)
+
+type shutdownHook struct {
+	f func()
+}
+
+var (
+	shutdownMu    sync.Mutex
+	shutdownHooks []*shutdownHook
+)
+
+// onShutdown registers f to be called by Shutdown before the Steam API is shut down,
+// in the order of registration. Calling remove unregisters f.
+func onShutdown(f func()) (remove func()) {
+	shutdownMu.Lock()
+	defer shutdownMu.Unlock()
+
+	h := &shutdownHook{f: f}
+	shutdownHooks = append(shutdownHooks, h)
+	return func() {
+		shutdownMu.Lock()
+		defer shutdownMu.Unlock()
+
+		for i, x := range shutdownHooks {
+			if x == h {
+				shutdownHooks = append(shutdownHooks[:i:i], shutdownHooks[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+func runShutdownHooks() {
+	shutdownMu.Lock()
+	hooks := shutdownHooks
+	shutdownMu.Unlock()
+
+	for _, h := range hooks {
+		h.f()
+	}
+}
+
+// Cleanup should be called as follows:
+//
+//	defer steamworks.Cleanup()()
+//
+// It locks the current OS thread and releases Steam API thread-local memory in the returned func.
+func Cleanup() func() {
+	runtime.LockOSThread()
+
+	return func() {
+		SteamAPI_ReleaseCurrentThreadMemory()
+		runtime.UnlockOSThread()
+	}
+}
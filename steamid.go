@@ -0,0 +1,310 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type EUniverse int32
+
+const (
+	EUniverseInvalid  EUniverse = 0
+	EUniversePublic   EUniverse = 1
+	EUniverseBeta     EUniverse = 2
+	EUniverseInternal EUniverse = 3
+	EUniverseDev      EUniverse = 4
+)
+
+type EAccountType int32
+
+const (
+	EAccountTypeInvalid        EAccountType = 0
+	EAccountTypeIndividual     EAccountType = 1 // single user account
+	EAccountTypeMultiseat      EAccountType = 2 // multiseat (e.g. cybercafe) account
+	EAccountTypeGameServer     EAccountType = 3 // game server account
+	EAccountTypeAnonGameServer EAccountType = 4 // anonymous game server account
+	EAccountTypePending        EAccountType = 5 // pending
+	EAccountTypeContentServer  EAccountType = 6 // content server
+	EAccountTypeClan           EAccountType = 7
+	EAccountTypeChat           EAccountType = 8
+	EAccountTypeConsoleUser    EAccountType = 9 // Fake SteamID for local PSN account on PS3 or Live account on 360, etc.
+	EAccountTypeAnonUser       EAccountType = 10
+)
+
+const (
+	// SteamUserDesktopInstance is the instance of the Steam ID of a user logged in with the desktop client.
+	SteamUserDesktopInstance = 1
+
+	// The instance of a chat Steam ID holds these flags.
+	ChatInstanceFlagClan     = 0x80000 // the chat room of a clan
+	ChatInstanceFlagLobby    = 0x40000 // a lobby
+	ChatInstanceFlagMMSLobby = 0x20000 // a matchmaking lobby
+)
+
+// A CSteamID packs, from the least significant bit, a 32-bit account ID, a 20-bit
+// instance, a 4-bit account type and an 8-bit universe.
+const (
+	steamIDInstanceShift = 32
+	steamIDTypeShift     = 52
+	steamIDUniverseShift = 56
+	steamIDInstanceMask  = 0xfffff
+)
+
+// NewSteamID returns the Steam ID made of the given fields.
+func NewSteamID(accountID uint32, instance uint32, accountType EAccountType, universe EUniverse) CSteamID {
+	return CSteamID(uint64(accountID) |
+		uint64(instance&steamIDInstanceMask)<<steamIDInstanceShift |
+		uint64(accountType&0xf)<<steamIDTypeShift |
+		uint64(universe&0xff)<<steamIDUniverseShift)
+}
+
+// SteamIDFromAccountID returns the Steam ID of the account in the public universe.
+// Individual accounts get the desktop instance, as Steam IDs of users usually have.
+func SteamIDFromAccountID(accountID uint32, accountType EAccountType) CSteamID {
+	var instance uint32
+	if accountType == EAccountTypeIndividual {
+		instance = SteamUserDesktopInstance
+	}
+	return NewSteamID(accountID, instance, accountType, EUniversePublic)
+}
+
+// AccountID returns the account ID, the lower 32 bits of the Steam ID.
+func (id CSteamID) AccountID() uint32 {
+	return uint32(id)
+}
+
+// Instance returns the instance of the account.
+func (id CSteamID) Instance() uint32 {
+	return uint32(id>>steamIDInstanceShift) & steamIDInstanceMask
+}
+
+// AccountType returns the type of the account.
+func (id CSteamID) AccountType() EAccountType {
+	return EAccountType(id>>steamIDTypeShift) & 0xf
+}
+
+// Universe returns the universe the account belongs to.
+func (id CSteamID) Universe() EUniverse {
+	return EUniverse(id >> steamIDUniverseShift)
+}
+
+// IsValid reports whether the Steam ID is well formed, following CSteamID::IsValid.
+func (id CSteamID) IsValid() bool {
+	t := id.AccountType()
+	if t <= EAccountTypeInvalid || t > EAccountTypeAnonUser {
+		return false
+	}
+	if u := id.Universe(); u <= EUniverseInvalid || u > EUniverseDev {
+		return false
+	}
+	switch t {
+	case EAccountTypeIndividual:
+		// Instances up to the web instance, 4, are valid.
+		if id.AccountID() == 0 || id.Instance() > 4 {
+			return false
+		}
+	case EAccountTypeClan:
+		if id.AccountID() == 0 || id.Instance() != 0 {
+			return false
+		}
+	case EAccountTypeGameServer:
+		if id.AccountID() == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// IsLobby reports whether the Steam ID is the ID of a lobby.
+func (id CSteamID) IsLobby() bool {
+	return id.AccountType() == EAccountTypeChat && id.Instance()&ChatInstanceFlagLobby != 0
+}
+
+// IsIndividual reports whether the Steam ID is the ID of a user.
+func (id CSteamID) IsIndividual() bool {
+	t := id.AccountType()
+	return t == EAccountTypeIndividual || t == EAccountTypeConsoleUser
+}
+
+// IsAnonGameServer reports whether the Steam ID is the ID of an anonymous game server.
+func (id CSteamID) IsAnonGameServer() bool {
+	return id.AccountType() == EAccountTypeAnonGameServer
+}
+
+// String returns the Steam ID as a 64-bit decimal number, such as 76561197960287930.
+func (id CSteamID) String() string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// Steam2 returns the Steam ID in the legacy STEAM_X:Y:Z form, such as STEAM_0:0:11101,
+// which only exists for individual accounts. The public universe is rendered as 0, as
+// most games do. Steam2 returns an empty string for other accounts.
+func (id CSteamID) Steam2() string {
+	if id.AccountType() != EAccountTypeIndividual {
+		return ""
+	}
+	u := id.Universe()
+	if u == EUniversePublic {
+		u = 0
+	}
+	return fmt.Sprintf("STEAM_%d:%d:%d", u, id.AccountID()&1, id.AccountID()>>1)
+}
+
+// steam3Letters maps the account types to the letters of the [X:U:A] form.
+var steam3Letters = map[EAccountType]byte{
+	EAccountTypeInvalid:        'I',
+	EAccountTypeIndividual:     'U',
+	EAccountTypeMultiseat:      'M',
+	EAccountTypeGameServer:     'G',
+	EAccountTypeAnonGameServer: 'A',
+	EAccountTypePending:        'P',
+	EAccountTypeContentServer:  'C',
+	EAccountTypeClan:           'g',
+	EAccountTypeChat:           'T',
+	EAccountTypeConsoleUser:    'i',
+	EAccountTypeAnonUser:       'a',
+}
+
+// Steam3 returns the Steam ID in the [X:U:A] form, such as [U:1:22202]. The instance is
+// appended when it is not the usual one of the account type.
+func (id CSteamID) Steam3() string {
+	t := id.AccountType()
+	letter, ok := steam3Letters[t]
+	if !ok {
+		// Steam renders the unknown account types as console users.
+		letter = steam3Letters[EAccountTypeConsoleUser]
+	}
+	if t == EAccountTypeChat {
+		if id.Instance()&ChatInstanceFlagClan != 0 {
+			letter = 'c'
+		} else if id.Instance()&ChatInstanceFlagLobby != 0 {
+			letter = 'L'
+		}
+	}
+
+	withInstance := t == EAccountTypeAnonGameServer || t == EAccountTypeMultiseat ||
+		t == EAccountTypeIndividual && id.Instance() != SteamUserDesktopInstance
+	if withInstance {
+		return fmt.Sprintf("[%c:%d:%d:%d]", letter, id.Universe(), id.AccountID(), id.Instance())
+	}
+	return fmt.Sprintf("[%c:%d:%d]", letter, id.Universe(), id.AccountID())
+}
+
+// ParseSteamID parses a Steam ID in the 64-bit decimal form, the STEAM_X:Y:Z form or
+// the [X:U:A] form.
+func ParseSteamID(s string) (CSteamID, error) {
+	var (
+		id  CSteamID
+		err error
+	)
+	switch {
+	case strings.HasPrefix(s, "STEAM_"):
+		id, err = parseSteam2(s)
+	case strings.HasPrefix(s, "["):
+		id, err = parseSteam3(s)
+	default:
+		var v uint64
+		v, err = strconv.ParseUint(s, 10, 64)
+		id = CSteamID(v)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("steamworks: invalid Steam ID %q: %w", s, err)
+	}
+	return id, nil
+}
+
+func parseSteam2(s string) (CSteamID, error) {
+	parts := strings.Split(strings.TrimPrefix(s, "STEAM_"), ":")
+	if len(parts) != 3 {
+		return 0, errors.New("want STEAM_X:Y:Z")
+	}
+	u, err := strconv.ParseUint(parts[0], 10, 8)
+	if err != nil {
+		return 0, err
+	}
+	y, err := strconv.ParseUint(parts[1], 10, 1)
+	if err != nil {
+		return 0, err
+	}
+	z, err := strconv.ParseUint(parts[2], 10, 31)
+	if err != nil {
+		return 0, err
+	}
+	// Games of the Orange Box era render the public universe as 0.
+	if u == 0 {
+		u = uint64(EUniversePublic)
+	}
+	return NewSteamID(uint32(z<<1|y), SteamUserDesktopInstance, EAccountTypeIndividual, EUniverse(u)), nil
+}
+
+func parseSteam3(s string) (CSteamID, error) {
+	if !strings.HasSuffix(s, "]") {
+		return 0, errors.New("want [X:U:A]")
+	}
+	parts := strings.Split(s[1:len(s)-1], ":")
+	if len(parts) != 3 && len(parts) != 4 || len(parts[0]) != 1 {
+		return 0, errors.New("want [X:U:A]")
+	}
+	u, err := strconv.ParseUint(parts[1], 10, 8)
+	if err != nil {
+		return 0, err
+	}
+	a, err := strconv.ParseUint(parts[2], 10, 32)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		t        EAccountType
+		instance uint32
+	)
+	switch letter := parts[0][0]; letter {
+	case 'c':
+		t, instance = EAccountTypeChat, ChatInstanceFlagClan
+	case 'L':
+		t, instance = EAccountTypeChat, ChatInstanceFlagLobby
+	default:
+		found := false
+		for at, l := range steam3Letters {
+			if l == letter {
+				t, found = at, true
+				break
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("unknown account type %q", letter)
+		}
+		if t == EAccountTypeIndividual {
+			instance = SteamUserDesktopInstance
+		}
+	}
+	if len(parts) == 4 {
+		i, err := strconv.ParseUint(parts[3], 10, 20)
+		if err != nil {
+			return 0, err
+		}
+		instance = uint32(i)
+	}
+	return NewSteamID(uint32(a), instance, t, EUniverse(u)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The Steam ID is encoded in the 64-bit
+// decimal form.
+func (id CSteamID) MarshalText() ([]byte, error) {
+	return []byte(id.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the forms ParseSteamID does.
+func (id *CSteamID) UnmarshalText(text []byte) error {
+	v, err := ParseSteamID(string(text))
+	if err != nil {
+		return err
+	}
+	*id = v
+	return nil
+}
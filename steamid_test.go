@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseSteamID(t *testing.T) {
+	gaben := NewSteamID(22202, SteamUserDesktopInstance, EAccountTypeIndividual, EUniversePublic)
+	tests := []struct {
+		in   string
+		want CSteamID
+	}{
+		{"76561197960287930", gaben},
+		{"STEAM_0:0:11101", gaben},
+		{"STEAM_1:0:11101", gaben},
+		{"STEAM_0:1:11101", NewSteamID(22203, SteamUserDesktopInstance, EAccountTypeIndividual, EUniversePublic)},
+		{"[U:1:22202]", gaben},
+		{"[U:1:22202:4]", NewSteamID(22202, 4, EAccountTypeIndividual, EUniversePublic)},
+		{"[g:1:4]", NewSteamID(4, 0, EAccountTypeClan, EUniversePublic)},
+		{"[G:1:1234]", NewSteamID(1234, 0, EAccountTypeGameServer, EUniversePublic)},
+		{"[A:1:1234:5]", NewSteamID(1234, 5, EAccountTypeAnonGameServer, EUniversePublic)},
+		{"[L:1:99]", NewSteamID(99, ChatInstanceFlagLobby, EAccountTypeChat, EUniversePublic)},
+		{"[c:1:99]", NewSteamID(99, ChatInstanceFlagClan, EAccountTypeChat, EUniversePublic)},
+		{"[T:1:99]", NewSteamID(99, 0, EAccountTypeChat, EUniversePublic)},
+		{"[i:1:7]", NewSteamID(7, 0, EAccountTypeConsoleUser, EUniversePublic)},
+		{"[a:1:7]", NewSteamID(7, 0, EAccountTypeAnonUser, EUniversePublic)},
+	}
+	for _, tt := range tests {
+		got, err := ParseSteamID(tt.in)
+		if err != nil {
+			t.Errorf("ParseSteamID(%q): %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseSteamID(%q) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseSteamIDErrors(t *testing.T) {
+	for _, in := range []string{
+		"",
+		"steamid",
+		"-1",
+		"18446744073709551616",
+		"STEAM_0:2:11101",
+		"STEAM_0:0",
+		"STEAM_0:0:x",
+		"[U:1:22202",
+		"[U:1]",
+		"[X:1:22202]",
+		"[UU:1:22202]",
+		"[U:1:22202:1048576]",
+		"[U:256:22202]",
+	} {
+		if id, err := ParseSteamID(in); err == nil {
+			t.Errorf("ParseSteamID(%q) = %d, want an error", in, id)
+		}
+	}
+}
+
+func TestSteamIDForms(t *testing.T) {
+	tests := []struct {
+		id             CSteamID
+		steam2, steam3 string
+	}{
+		{NewSteamID(22202, SteamUserDesktopInstance, EAccountTypeIndividual, EUniversePublic), "STEAM_0:0:11101", "[U:1:22202]"},
+		{NewSteamID(22203, SteamUserDesktopInstance, EAccountTypeIndividual, EUniverseBeta), "STEAM_2:1:11101", "[U:2:22203]"},
+		{NewSteamID(22202, 4, EAccountTypeIndividual, EUniversePublic), "STEAM_0:0:11101", "[U:1:22202:4]"},
+		{NewSteamID(4, 0, EAccountTypeClan, EUniversePublic), "", "[g:1:4]"},
+		{NewSteamID(1234, 5, EAccountTypeAnonGameServer, EUniversePublic), "", "[A:1:1234:5]"},
+		{NewSteamID(99, ChatInstanceFlagLobby|ChatInstanceFlagMMSLobby, EAccountTypeChat, EUniversePublic), "", "[L:1:99]"},
+		{NewSteamID(99, ChatInstanceFlagClan, EAccountTypeChat, EUniversePublic), "", "[c:1:99]"},
+		{NewSteamID(7, 0, EAccountTypeConsoleUser, EUniversePublic), "", "[i:1:7]"},
+	}
+	for _, tt := range tests {
+		if got := tt.id.Steam2(); got != tt.steam2 {
+			t.Errorf("%d.Steam2() = %q, want %q", tt.id, got, tt.steam2)
+		}
+		if got := tt.id.Steam3(); got != tt.steam3 {
+			t.Errorf("%d.Steam3() = %q, want %q", tt.id, got, tt.steam3)
+		}
+	}
+}
+
+func TestSteamIDRoundTrip(t *testing.T) {
+	ids := []CSteamID{
+		0,
+		NewSteamID(22202, SteamUserDesktopInstance, EAccountTypeIndividual, EUniversePublic),
+		NewSteamID(22202, 4, EAccountTypeIndividual, EUniversePublic),
+		NewSteamID(4, 0, EAccountTypeClan, EUniversePublic),
+		NewSteamID(1234, 0, EAccountTypeGameServer, EUniverseDev),
+		NewSteamID(1234, 5, EAccountTypeAnonGameServer, EUniversePublic),
+		NewSteamID(99, ChatInstanceFlagLobby, EAccountTypeChat, EUniversePublic),
+		NewSteamID(99, ChatInstanceFlagClan, EAccountTypeChat, EUniversePublic),
+		NewSteamID(7, 0, EAccountTypeConsoleUser, EUniversePublic),
+		NewSteamID(7, 0, EAccountTypeAnonUser, EUniversePublic),
+	}
+	for _, id := range ids {
+		for _, s := range []string{id.String(), id.Steam3()} {
+			got, err := ParseSteamID(s)
+			if err != nil {
+				t.Errorf("ParseSteamID(%q): %v", s, err)
+				continue
+			}
+			if got != id {
+				t.Errorf("ParseSteamID(%q) = %d, want %d", s, got, id)
+			}
+		}
+
+		text, err := id.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got CSteamID
+		if err := got.UnmarshalText(text); err != nil {
+			t.Errorf("UnmarshalText(%q): %v", text, err)
+		} else if got != id {
+			t.Errorf("UnmarshalText(%q) = %d, want %d", text, got, id)
+		}
+	}
+}
+
+func TestSteamIDJSON(t *testing.T) {
+	type user struct {
+		ID CSteamID `json:"id"`
+	}
+	want := user{NewSteamID(22202, SteamUserDesktopInstance, EAccountTypeIndividual, EUniversePublic)}
+	data, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"id":"76561197960287930"}` {
+		t.Errorf("json.Marshal = %s", data)
+	}
+
+	var got user
+	if err := json.Unmarshal([]byte(`{"id":"[U:1:22202]"}`), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("json.Unmarshal = %d, want %d", got.ID, want.ID)
+	}
+}
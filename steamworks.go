@@ -1,145 +1,160 @@
 // SPDX-License-Identifier: Apache-2.0
 // SPDX-FileCopyrightText: 2021 The go-steamworks Authors
-//go:generate go run gen.go
+//go:generate go run gen.go -version 155
+//go:generate go run gencallbacks.go
 
 package steamworks
 
+import "encoding/binary"
+
 type AppId_t uint32
+type DepotId_t uint32
 type CSteamID uint64
 type InputHandle_t uint64
 
 type ESteamInputType int32
 type EResult int32
+type ESteamAPIInitResult int32
+
+const (
+	ESteamAPIInitResult_OK              ESteamAPIInitResult = 0
+	ESteamAPIInitResult_FailedGeneric   ESteamAPIInitResult = 1 // Some other failure
+	ESteamAPIInitResult_NoSteamClient   ESteamAPIInitResult = 2 // We cannot connect to Steam, steam probably isn't running
+	ESteamAPIInitResult_VersionMismatch ESteamAPIInitResult = 3 // Steam client appears to be out of date
+)
+
+// steamErrMsg mirrors SteamErrMsg, the buffer SteamAPI_InitFlat writes its error message to.
+type steamErrMsg [1024]byte
 
 const (
 	EResultNone         EResult = 0
-	EResultOK                   = 1
-	EResultFail                 = 2
-	EResultNoConnection         = 3
+	EResultOK           EResult = 1
+	EResultFail         EResult = 2
+	EResultNoConnection EResult = 3
 	// EResultNoConnectionRetry = 4				// OBSOLETE - removed
-	EResultInvalidPassword                         = 5  // password/ticket is invalid
-	EResultLoggedInElsewhere                       = 6  // same user logged in elsewhere
-	EResultInvalidProtocolVer                      = 7  // protocol version is incorrect
-	EResultInvalidParam                            = 8  // a parameter is incorrect
-	EResultFileNotFound                            = 9  // file was not found
-	EResultBusy                                    = 10 // called method busy - action not taken
-	EResultInvalidState                            = 11 // called object was in an invalid state
-	EResultInvalidName                             = 12 // name is invalid
-	EResultInvalidEmail                            = 13 // email is invalid
-	EResultDuplicateName                           = 14 // name is not unique
-	EResultAccessDenied                            = 15 // access is denied
-	EResultTimeout                                 = 16 // operation timed out
-	EResultBanned                                  = 17 // VAC2 banned
-	EResultAccountNotFound                         = 18 // account not found
-	EResultInvalidSteamID                          = 19 // steamID is invalid
-	EResultServiceUnavailable                      = 20 // The requested service is currently unavailable
-	EResultNotLoggedOn                             = 21 // The user is not logged on
-	EResultPending                                 = 22 // Request is pending (may be in process or waiting on third party)
-	EResultEncryptionFailure                       = 23 // Encryption or Decryption failed
-	EResultInsufficientPrivilege                   = 24 // Insufficient privilege
-	EResultLimitExceeded                           = 25 // Too much of a good thing
-	EResultRevoked                                 = 26 // Access has been revoked (used for revoked guest passes)
-	EResultExpired                                 = 27 // License/Guest pass the user is trying to access is expired
-	EResultAlreadyRedeemed                         = 28 // Guest pass has already been redeemed by account cannot be acked again
-	EResultDuplicateRequest                        = 29 // The request is a duplicate and the action has already occurred in the past ignored this time
-	EResultAlreadyOwned                            = 30 // All the games in this guest pass redemption request are already owned by the user
-	EResultIPNotFound                              = 31 // IP address not found
-	EResultPersistFailed                           = 32 // failed to write change to the data store
-	EResultLockingFailed                           = 33 // failed to acquire access lock for this operation
-	EResultLogonSessionReplaced                    = 34
-	EResultConnectFailed                           = 35
-	EResultHandshakeFailed                         = 36
-	EResultIOFailure                               = 37
-	EResultRemoteDisconnect                        = 38
-	EResultShoppingCartNotFound                    = 39 // failed to find the shopping cart requested
-	EResultBlocked                                 = 40 // a user didn't allow it
-	EResultIgnored                                 = 41 // target is ignoring sender
-	EResultNoMatch                                 = 42 // nothing matching the request found
-	EResultAccountDisabled                         = 43
-	EResultServiceReadOnly                         = 44 // this service is not accepting content changes right now
-	EResultAccountNotFeatured                      = 45 // account doesn't have value so this feature isn't available
-	EResultAdministratorOK                         = 46 // allowed to take this action but only because requester is admin
-	EResultContentVersion                          = 47 // A Version mismatch in content transmitted within the Steam protocol.
-	EResultTryAnotherCM                            = 48 // The current CM can't service the user making a request user should try another.
-	EResultPasswordRequiredToKickSession           = 49 // You are already logged in elsewhere this cached credential login has failed.
-	EResultAlreadyLoggedInElsewhere                = 50 // You are already logged in elsewhere you must wait
-	EResultSuspended                               = 51 // Long running operation (content download) suspended/paused
-	EResultCancelled                               = 52 // Operation canceled (typically by user: content download)
-	EResultDataCorruption                          = 53 // Operation canceled because data is ill formed or unrecoverable
-	EResultDiskFull                                = 54 // Operation canceled - not enough disk space.
-	EResultRemoteCallFailed                        = 55 // an remote call or IPC call failed
-	EResultPasswordUnset                           = 56 // Password could not be verified as it's unset server side
-	EResultExternalAccountUnlinked                 = 57 // External account (PSN Facebook...) is not linked to a Steam account
-	EResultPSNTicketInvalid                        = 58 // PSN ticket was invalid
-	EResultExternalAccountAlreadyLinked            = 59 // External account (PSN Facebook...) is already linked to some other account must explicitly request to replace/delete the link first
-	EResultRemoteFileConflict                      = 60 // The sync cannot resume due to a conflict between the local and remote files
-	EResultIllegalPassword                         = 61 // The requested new password is not legal
-	EResultSameAsPreviousValue                     = 62 // new value is the same as the old one ( secret question and answer )
-	EResultAccountLogonDenied                      = 63 // account login denied due to 2nd factor authentication failure
-	EResultCannotUseOldPassword                    = 64 // The requested new password is not legal
-	EResultInvalidLoginAuthCode                    = 65 // account login denied due to auth code invalid
-	EResultAccountLogonDeniedNoMail                = 66 // account login denied due to 2nd factor auth failure - and no mail has been sent - partner site specific
-	EResultHardwareNotCapableOfIPT                 = 67 //
-	EResultIPTInitError                            = 68 //
-	EResultParentalControlRestricted               = 69 // operation failed due to parental control restrictions for current user
-	EResultFacebookQueryError                      = 70 // Facebook query returned an error
-	EResultExpiredLoginAuthCode                    = 71 // account login denied due to auth code expired
-	EResultIPLoginRestrictionFailed                = 72
-	EResultAccountLockedDown                       = 73
-	EResultAccountLogonDeniedVerifiedEmailRequired = 74
-	EResultNoMatchingURL                           = 75
-	EResultBadResponse                             = 76  // parse failure missing field etc.
-	EResultRequirePasswordReEntry                  = 77  // The user cannot complete the action until they re-enter their password
-	EResultValueOutOfRange                         = 78  // the value entered is outside the acceptable range
-	EResultUnexpectedError                         = 79  // something happened that we didn't expect to ever happen
-	EResultDisabled                                = 80  // The requested service has been configured to be unavailable
-	EResultInvalidCEGSubmission                    = 81  // The set of files submitted to the CEG server are not valid !
-	EResultRestrictedDevice                        = 82  // The device being used is not allowed to perform this action
-	EResultRegionLocked                            = 83  // The action could not be complete because it is region restricted
-	EResultRateLimitExceeded                       = 84  // Temporary rate limit exceeded try again later different from EResultLimitExceeded which may be permanent
-	EResultAccountLoginDeniedNeedTwoFactor         = 85  // Need two-factor code to login
-	EResultItemDeleted                             = 86  // The thing we're trying to access has been deleted
-	EResultAccountLoginDeniedThrottle              = 87  // login attempt failed try to throttle response to possible attacker
-	EResultTwoFactorCodeMismatch                   = 88  // two factor code mismatch
-	EResultTwoFactorActivationCodeMismatch         = 89  // activation code for two-factor didn't match
-	EResultAccountAssociatedToMultiplePartners     = 90  // account has been associated with multiple partners
-	EResultNotModified                             = 91  // data not modified
-	EResultNoMobileDevice                          = 92  // the account does not have a mobile device associated with it
-	EResultTimeNotSynced                           = 93  // the time presented is out of range or tolerance
-	EResultSmsCodeFailed                           = 94  // SMS code failure (no match none pending etc.)
-	EResultAccountLimitExceeded                    = 95  // Too many accounts access this resource
-	EResultAccountActivityLimitExceeded            = 96  // Too many changes to this account
-	EResultPhoneActivityLimitExceeded              = 97  // Too many changes to this phone
-	EResultRefundToWallet                          = 98  // Cannot refund to payment method must use wallet
-	EResultEmailSendFailure                        = 99  // Cannot send an email
-	EResultNotSettled                              = 100 // Can't perform operation till payment has settled
-	EResultNeedCaptcha                             = 101 // Needs to provide a valid captcha
-	EResultGSLTDenied                              = 102 // a game server login token owned by this token's owner has been banned
-	EResultGSOwnerDenied                           = 103 // game server owner is denied for other reason (account lock community ban vac ban missing phone)
-	EResultInvalidItemType                         = 104 // the type of thing we were requested to act on is invalid
-	EResultIPBanned                                = 105 // the ip address has been banned from taking this action
-	EResultGSLTExpired                             = 106 // this token has expired from disuse; can be reset for use
-	EResultInsufficientFunds                       = 107 // user doesn't have enough wallet funds to complete the action
-	EResultTooManyPending                          = 108 // There are too many of this thing pending already
-	EResultNoSiteLicensesFound                     = 109 // No site licenses found
-	EResultWGNetworkSendExceeded                   = 110 // the WG couldn't send a response because we exceeded max network send size
-	EResultAccountNotFriends                       = 111 // the user is not mutually friends
-	EResultLimitedUserAccount                      = 112 // the user is limited
-	EResultCantRemoveItem                          = 113 // item can't be removed
-	EResultAccountDeleted                          = 114 // account has been deleted
-	EResultExistingUserCancelledLicense            = 115 // A license for this already exists but cancelled
-	EResultCommunityCooldown                       = 116 // access is denied because of a community cooldown (probably from support profile data resets)
-	EResultNoLauncherSpecified                     = 117 // No launcher was specified but a launcher was needed to choose correct realm for operation.
-	EResultMustAgreeToSSA                          = 118 // User must agree to china SSA or global SSA before login
-	EResultLauncherMigrated                        = 119 // The specified launcher type is no longer supported; the user should be directed elsewhere
-	EResultSteamRealmMismatch                      = 120 // The user's realm does not match the realm of the requested resource
-	EResultInvalidSignature                        = 121 // signature check did not match
-	EResultParseFailure                            = 122 // Failed to parse input
-	EResultNoVerifiedPhone                         = 123 // account does not have a verified phone number
-	EResultInsufficientBattery                     = 124 // user device doesn't have enough battery charge currently to complete the action
-	EResultChargerRequired                         = 125 // The operation requires a charger to be plugged in which wasn't present
-	EResultCachedCredentialInvalid                 = 126 // Cached credential was invalid - user must reauthenticate
-	EResultPhoneNumberIsVOIP                       = 127 // The phone number provided is a Voice Over IP number
+	EResultInvalidPassword                         EResult = 5  // password/ticket is invalid
+	EResultLoggedInElsewhere                       EResult = 6  // same user logged in elsewhere
+	EResultInvalidProtocolVer                      EResult = 7  // protocol version is incorrect
+	EResultInvalidParam                            EResult = 8  // a parameter is incorrect
+	EResultFileNotFound                            EResult = 9  // file was not found
+	EResultBusy                                    EResult = 10 // called method busy - action not taken
+	EResultInvalidState                            EResult = 11 // called object was in an invalid state
+	EResultInvalidName                             EResult = 12 // name is invalid
+	EResultInvalidEmail                            EResult = 13 // email is invalid
+	EResultDuplicateName                           EResult = 14 // name is not unique
+	EResultAccessDenied                            EResult = 15 // access is denied
+	EResultTimeout                                 EResult = 16 // operation timed out
+	EResultBanned                                  EResult = 17 // VAC2 banned
+	EResultAccountNotFound                         EResult = 18 // account not found
+	EResultInvalidSteamID                          EResult = 19 // steamID is invalid
+	EResultServiceUnavailable                      EResult = 20 // The requested service is currently unavailable
+	EResultNotLoggedOn                             EResult = 21 // The user is not logged on
+	EResultPending                                 EResult = 22 // Request is pending (may be in process or waiting on third party)
+	EResultEncryptionFailure                       EResult = 23 // Encryption or Decryption failed
+	EResultInsufficientPrivilege                   EResult = 24 // Insufficient privilege
+	EResultLimitExceeded                           EResult = 25 // Too much of a good thing
+	EResultRevoked                                 EResult = 26 // Access has been revoked (used for revoked guest passes)
+	EResultExpired                                 EResult = 27 // License/Guest pass the user is trying to access is expired
+	EResultAlreadyRedeemed                         EResult = 28 // Guest pass has already been redeemed by account cannot be acked again
+	EResultDuplicateRequest                        EResult = 29 // The request is a duplicate and the action has already occurred in the past ignored this time
+	EResultAlreadyOwned                            EResult = 30 // All the games in this guest pass redemption request are already owned by the user
+	EResultIPNotFound                              EResult = 31 // IP address not found
+	EResultPersistFailed                           EResult = 32 // failed to write change to the data store
+	EResultLockingFailed                           EResult = 33 // failed to acquire access lock for this operation
+	EResultLogonSessionReplaced                    EResult = 34
+	EResultConnectFailed                           EResult = 35
+	EResultHandshakeFailed                         EResult = 36
+	EResultIOFailure                               EResult = 37
+	EResultRemoteDisconnect                        EResult = 38
+	EResultShoppingCartNotFound                    EResult = 39 // failed to find the shopping cart requested
+	EResultBlocked                                 EResult = 40 // a user didn't allow it
+	EResultIgnored                                 EResult = 41 // target is ignoring sender
+	EResultNoMatch                                 EResult = 42 // nothing matching the request found
+	EResultAccountDisabled                         EResult = 43
+	EResultServiceReadOnly                         EResult = 44 // this service is not accepting content changes right now
+	EResultAccountNotFeatured                      EResult = 45 // account doesn't have value so this feature isn't available
+	EResultAdministratorOK                         EResult = 46 // allowed to take this action but only because requester is admin
+	EResultContentVersion                          EResult = 47 // A Version mismatch in content transmitted within the Steam protocol.
+	EResultTryAnotherCM                            EResult = 48 // The current CM can't service the user making a request user should try another.
+	EResultPasswordRequiredToKickSession           EResult = 49 // You are already logged in elsewhere this cached credential login has failed.
+	EResultAlreadyLoggedInElsewhere                EResult = 50 // You are already logged in elsewhere you must wait
+	EResultSuspended                               EResult = 51 // Long running operation (content download) suspended/paused
+	EResultCancelled                               EResult = 52 // Operation canceled (typically by user: content download)
+	EResultDataCorruption                          EResult = 53 // Operation canceled because data is ill formed or unrecoverable
+	EResultDiskFull                                EResult = 54 // Operation canceled - not enough disk space.
+	EResultRemoteCallFailed                        EResult = 55 // an remote call or IPC call failed
+	EResultPasswordUnset                           EResult = 56 // Password could not be verified as it's unset server side
+	EResultExternalAccountUnlinked                 EResult = 57 // External account (PSN Facebook...) is not linked to a Steam account
+	EResultPSNTicketInvalid                        EResult = 58 // PSN ticket was invalid
+	EResultExternalAccountAlreadyLinked            EResult = 59 // External account (PSN Facebook...) is already linked to some other account must explicitly request to replace/delete the link first
+	EResultRemoteFileConflict                      EResult = 60 // The sync cannot resume due to a conflict between the local and remote files
+	EResultIllegalPassword                         EResult = 61 // The requested new password is not legal
+	EResultSameAsPreviousValue                     EResult = 62 // new value is the same as the old one ( secret question and answer )
+	EResultAccountLogonDenied                      EResult = 63 // account login denied due to 2nd factor authentication failure
+	EResultCannotUseOldPassword                    EResult = 64 // The requested new password is not legal
+	EResultInvalidLoginAuthCode                    EResult = 65 // account login denied due to auth code invalid
+	EResultAccountLogonDeniedNoMail                EResult = 66 // account login denied due to 2nd factor auth failure - and no mail has been sent - partner site specific
+	EResultHardwareNotCapableOfIPT                 EResult = 67 //
+	EResultIPTInitError                            EResult = 68 //
+	EResultParentalControlRestricted               EResult = 69 // operation failed due to parental control restrictions for current user
+	EResultFacebookQueryError                      EResult = 70 // Facebook query returned an error
+	EResultExpiredLoginAuthCode                    EResult = 71 // account login denied due to auth code expired
+	EResultIPLoginRestrictionFailed                EResult = 72
+	EResultAccountLockedDown                       EResult = 73
+	EResultAccountLogonDeniedVerifiedEmailRequired EResult = 74
+	EResultNoMatchingURL                           EResult = 75
+	EResultBadResponse                             EResult = 76  // parse failure missing field etc.
+	EResultRequirePasswordReEntry                  EResult = 77  // The user cannot complete the action until they re-enter their password
+	EResultValueOutOfRange                         EResult = 78  // the value entered is outside the acceptable range
+	EResultUnexpectedError                         EResult = 79  // something happened that we didn't expect to ever happen
+	EResultDisabled                                EResult = 80  // The requested service has been configured to be unavailable
+	EResultInvalidCEGSubmission                    EResult = 81  // The set of files submitted to the CEG server are not valid !
+	EResultRestrictedDevice                        EResult = 82  // The device being used is not allowed to perform this action
+	EResultRegionLocked                            EResult = 83  // The action could not be complete because it is region restricted
+	EResultRateLimitExceeded                       EResult = 84  // Temporary rate limit exceeded try again later different from EResultLimitExceeded which may be permanent
+	EResultAccountLoginDeniedNeedTwoFactor         EResult = 85  // Need two-factor code to login
+	EResultItemDeleted                             EResult = 86  // The thing we're trying to access has been deleted
+	EResultAccountLoginDeniedThrottle              EResult = 87  // login attempt failed try to throttle response to possible attacker
+	EResultTwoFactorCodeMismatch                   EResult = 88  // two factor code mismatch
+	EResultTwoFactorActivationCodeMismatch         EResult = 89  // activation code for two-factor didn't match
+	EResultAccountAssociatedToMultiplePartners     EResult = 90  // account has been associated with multiple partners
+	EResultNotModified                             EResult = 91  // data not modified
+	EResultNoMobileDevice                          EResult = 92  // the account does not have a mobile device associated with it
+	EResultTimeNotSynced                           EResult = 93  // the time presented is out of range or tolerance
+	EResultSmsCodeFailed                           EResult = 94  // SMS code failure (no match none pending etc.)
+	EResultAccountLimitExceeded                    EResult = 95  // Too many accounts access this resource
+	EResultAccountActivityLimitExceeded            EResult = 96  // Too many changes to this account
+	EResultPhoneActivityLimitExceeded              EResult = 97  // Too many changes to this phone
+	EResultRefundToWallet                          EResult = 98  // Cannot refund to payment method must use wallet
+	EResultEmailSendFailure                        EResult = 99  // Cannot send an email
+	EResultNotSettled                              EResult = 100 // Can't perform operation till payment has settled
+	EResultNeedCaptcha                             EResult = 101 // Needs to provide a valid captcha
+	EResultGSLTDenied                              EResult = 102 // a game server login token owned by this token's owner has been banned
+	EResultGSOwnerDenied                           EResult = 103 // game server owner is denied for other reason (account lock community ban vac ban missing phone)
+	EResultInvalidItemType                         EResult = 104 // the type of thing we were requested to act on is invalid
+	EResultIPBanned                                EResult = 105 // the ip address has been banned from taking this action
+	EResultGSLTExpired                             EResult = 106 // this token has expired from disuse; can be reset for use
+	EResultInsufficientFunds                       EResult = 107 // user doesn't have enough wallet funds to complete the action
+	EResultTooManyPending                          EResult = 108 // There are too many of this thing pending already
+	EResultNoSiteLicensesFound                     EResult = 109 // No site licenses found
+	EResultWGNetworkSendExceeded                   EResult = 110 // the WG couldn't send a response because we exceeded max network send size
+	EResultAccountNotFriends                       EResult = 111 // the user is not mutually friends
+	EResultLimitedUserAccount                      EResult = 112 // the user is limited
+	EResultCantRemoveItem                          EResult = 113 // item can't be removed
+	EResultAccountDeleted                          EResult = 114 // account has been deleted
+	EResultExistingUserCancelledLicense            EResult = 115 // A license for this already exists but cancelled
+	EResultCommunityCooldown                       EResult = 116 // access is denied because of a community cooldown (probably from support profile data resets)
+	EResultNoLauncherSpecified                     EResult = 117 // No launcher was specified but a launcher was needed to choose correct realm for operation.
+	EResultMustAgreeToSSA                          EResult = 118 // User must agree to china SSA or global SSA before login
+	EResultLauncherMigrated                        EResult = 119 // The specified launcher type is no longer supported; the user should be directed elsewhere
+	EResultSteamRealmMismatch                      EResult = 120 // The user's realm does not match the realm of the requested resource
+	EResultInvalidSignature                        EResult = 121 // signature check did not match
+	EResultParseFailure                            EResult = 122 // Failed to parse input
+	EResultNoVerifiedPhone                         EResult = 123 // account does not have a verified phone number
+	EResultInsufficientBattery                     EResult = 124 // user device doesn't have enough battery charge currently to complete the action
+	EResultChargerRequired                         EResult = 125 // The operation requires a charger to be plugged in which wasn't present
+	EResultCachedCredentialInvalid                 EResult = 126 // Cached credential was invalid - user must reauthenticate
+	EResultPhoneNumberIsVOIP                       EResult = 127 // The phone number provided is a Voice Over IP number
 )
 
 type HSteamListenSocket int32
@@ -191,27 +206,52 @@ type IPv4MappedAddress struct {
 	IP         [4]byte // In network byte order
 }
 
+// SteamNetworkingIPAddr is an IPv6 address and a port. An IPv4 address is stored in the
+// IPv6 bytes in the form of IPv4MappedAddress.
 type SteamNetworkingIPAddr struct {
 	IPv6 [16]byte
-	IPv4 IPv4MappedAddress
-	Port uint16
+	Port uint16 // In host byte order
 }
 
 type ESteamNetworkingIdentityType int32
 
+const (
+	ESteamNetworkingIdentityType_Invalid        ESteamNetworkingIdentityType = 0
+	ESteamNetworkingIdentityType_SteamID        ESteamNetworkingIdentityType = 16
+	ESteamNetworkingIdentityType_XboxPairwiseID ESteamNetworkingIdentityType = 17
+	ESteamNetworkingIdentityType_SonyPSN        ESteamNetworkingIdentityType = 18
+	ESteamNetworkingIdentityType_IPAddress      ESteamNetworkingIdentityType = 1
+	ESteamNetworkingIdentityType_GenericString  ESteamNetworkingIdentityType = 2
+	ESteamNetworkingIdentityType_GenericBytes   ESteamNetworkingIdentityType = 3
+	ESteamNetworkingIdentityType_UnknownType    ESteamNetworkingIdentityType = 4
+)
+
 type ESteamNetworkingFakeIPType int32
 
+// SteamNetworkingIdentity identifies a peer. In C, the Steam ID, the IP address, the
+// strings and the bytes of the identity share the same 128 bytes, interpreted
+// according to EType.
 type SteamNetworkingIdentity struct {
-	EType            ESteamNetworkingIdentityType
-	SteamID64        uint64
-	PSNID            uint64
-	StadiaID         uint64
-	GenericString    [32]byte
-	XboxPairwiseID   [33]byte
-	GenericBytes     [32]byte
-	UnknownRawString [128]byte
-	IP               SteamNetworkingIPAddr
-	Reserved         [32]uint32
+	EType ESteamNetworkingIdentityType
+	Size  int32 // m_cbSize, the number of bytes used
+	data  [128]byte
+}
+
+// SteamID64 returns the Steam ID of the identity, or 0 if it is not a Steam ID.
+func (i *SteamNetworkingIdentity) SteamID64() uint64 {
+	if i.EType != ESteamNetworkingIdentityType_SteamID {
+		return 0
+	}
+	return binary.LittleEndian.Uint64(i.data[:])
+}
+
+// SetSteamID64 makes the identity the Steam ID id.
+func (i *SteamNetworkingIdentity) SetSteamID64(id uint64) {
+	*i = SteamNetworkingIdentity{
+		EType: ESteamNetworkingIdentityType_SteamID,
+		Size:  8,
+	}
+	binary.LittleEndian.PutUint64(i.data[:], id)
 }
 
 type SteamNetConnectionInfo_t struct {
@@ -234,28 +274,28 @@ type SteamNetConnectionRealTimeStatus_t struct {
 	/// High level state of the connection
 	State ESteamNetworkingConnectionState
 	/// Current ping (ms)
-	Ping int
+	Ping int32
 
 	/// Connection quality measured locally, 0...1.  (Percentage of packets delivered
 	/// end-to-end in order).
-	ConnectionQualityLocal float64
+	ConnectionQualityLocal float32
 
 	/// Packet delivery success rate as observed from remote host
-	ConnectionQualityRemote float64
+	ConnectionQualityRemote float32
 
 	/// Current data rates from recent history.
-	OutPacketsPerSec float64
-	OutBytesPerSec   float64
-	InPacketsPerSec  float64
-	InBytesPerSec    float64
-
-	SendRateBytesPerSecond int
-	PendingUnreliable      int
-	PendingReliable        int
+	OutPacketsPerSec float32
+	OutBytesPerSec   float32
+	InPacketsPerSec  float32
+	InBytesPerSec    float32
+
+	SendRateBytesPerSecond int32
+	PendingUnreliable      int32
+	PendingReliable        int32
 	/// Number of bytes of reliable data that has been placed the wire, but
 	/// for which we have not yet received an acknowledgment, and thus we may
 	/// have to re-transmit.
-	SentUnackedReliable int
+	SentUnackedReliable int32
 	QueueTime           SteamNetworkingMicroseconds
 	// Internal stuff, room to change API easily
 	reserved [16]uint32
@@ -269,6 +309,8 @@ type SteamNetworkingMessage_t struct {
 	ConnUserData  int64
 	TimeReceived  SteamNetworkingMicroseconds // m_usecTimeReceived
 	MessageNumber int64                       // m_nMessageNumber
+	FreeData      uintptr                     // m_pfnFreeData
+	Release       uintptr                     // m_pfnRelease
 	Channel       int32                       // m_nChannel
 	Flags         int32                       // m_nFlags
 	UserData      int64                       // m_nUserData
@@ -379,7 +421,7 @@ const (
 
 type SteamAPICallCompleted_t struct {
 	m_hAsyncCall SteamAPICallbackHandle
-	m_iCallback  int
+	m_iCallback  int32
 	m_cubParam   uint32
 }
 
@@ -408,8 +450,31 @@ const (
 )
 
 type ISteamApps interface {
+	BIsSubscribed() bool
+	BIsSubscribedApp(appID AppId_t) bool
+	BIsLowViolence() bool
+	BIsVACBanned() bool
+	BIsSubscribedFromFreeWeekend() bool
+	BIsSubscribedFromFamilySharing() bool
+	GetAppOwner() CSteamID
+	GetEarliestPurchaseUnixTime(appID AppId_t) uint32
+	GetAppBuildId() int32
+	GetCurrentBetaName() (name string, ok bool)
+	GetDLCCount() int32
+	BGetDLCDataByIndex(iDLC int32) (appID AppId_t, available bool, name string, success bool)
+	BIsDlcInstalled(appID AppId_t) bool
+	InstallDLC(appID AppId_t)
+	UninstallDLC(appID AppId_t)
+	GetDlcDownloadProgress(appID AppId_t) (bytesDownloaded, bytesTotal uint64, downloading bool)
+	BIsAppInstalled(appID AppId_t) bool
+	GetInstalledDepots(appID AppId_t) []DepotId_t
+	GetFileDetails(fileName string) *CallResult[FileDetailsResult_t]
+	MarkContentCorrupt(missingFilesOnly bool) bool
 	GetAppInstallDir(appID AppId_t) string
 	GetCurrentGameLanguage() string
+	GetAvailableGameLanguages() string
+	GetLaunchQueryParam(key string) string
+	GetLaunchCommandLine() string
 }
 
 type ISteamInput interface {
@@ -440,6 +505,8 @@ type ISteamUserStats interface {
 
 type ISteamUtils interface {
 	IsSteamRunningOnSteamDeck() bool
+	GetAPICallFailureReason(call SteamAPICallbackHandle) ESteamAPICallFailure
+	GetSteamUILanguage() string
 }
 
 // type ISteamNetworkingSockets interface {
@@ -453,7 +520,8 @@ type ISteamUtils interface {
 
 type ISteamNetworkingMessages interface {
 	SendMessageToUser(identity SteamNetworkingIdentity, data []byte, sendFlags int32, channel int32) EResult
-	ReceiveMessagesOnChannel(localChannel int32, maxMessages int32) ([]SteamNetworkingMessage_t, EResult)
+	ReceiveMessagesOnChannel(localChannel int32, maxMessages int32) []NetworkingMessage
+	BorrowMessagesOnChannel(localChannel int32, maxMessages int32, pool *MessagePool) []NetworkingMessage
 	AcceptSessionWithUser(identityRemote SteamNetworkingIdentity) bool
 	CloseSessionWithUser(identityRemote SteamNetworkingIdentity) bool
 	CloseChannelWithUser(identityRemote SteamNetworkingIdentity, nLocalChannel int32) bool
@@ -461,8 +529,8 @@ type ISteamNetworkingMessages interface {
 }
 
 type ISteamMatchmaking interface {
-	CreateLobby(eLobbyType ELobbyType, cMaxMembers int32) (LobbyCreated_t, error)
-	RequestLobbyList() (list LobbyMatchList_t, err error)
+	CreateLobby(eLobbyType ELobbyType, cMaxMembers int32) *CallResult[LobbyCreated_t]
+	RequestLobbyList() *CallResult[LobbyMatchList_t]
 	// GetLobbyByIndex(iLobby int) CSteamID
 	// JoinLobby(steamIDLobby CSteamID) SteamAPICall_t
 	GetLobbyByIndex(iLobby int32) CSteamID
@@ -491,55 +559,12 @@ type ISteamMatchmaking interface {
 	// SetLinkedLobby(steamIDLobby, steamIDLobbyDependent CSteamID) bool
 }
 
-const (
-	flatAPI_RestartAppIfNecessary = "SteamAPI_RestartAppIfNecessary"
-	flatAPI_Init                  = "SteamAPI_Init"
-	flatAPI_RunCallbacks          = "SteamAPI_RunCallbacks"
-
-	flatAPI_SteamApps                         = "SteamAPI_SteamApps_v008"
-	flatAPI_ISteamApps_GetAppInstallDir       = "SteamAPI_ISteamApps_GetAppInstallDir"
-	flatAPI_ISteamApps_GetCurrentGameLanguage = "SteamAPI_ISteamApps_GetCurrentGameLanguage"
-
-	flatAPI_SteamInput                          = "SteamAPI_SteamInput_v006"
-	flatAPI_ISteamInput_GetConnectedControllers = "SteamAPI_ISteamInput_GetConnectedControllers"
-	flatAPI_ISteamInput_GetInputTypeForHandle   = "SteamAPI_ISteamInput_GetInputTypeForHandle"
-	flatAPI_ISteamInput_Init                    = "SteamAPI_ISteamInput_Init"
-	flatAPI_ISteamInput_RunFrame                = "SteamAPI_ISteamInput_RunFrame"
-
-	flatAPI_SteamRemoteStorage              = "SteamAPI_SteamRemoteStorage_v016"
-	flatAPI_ISteamRemoteStorage_FileWrite   = "SteamAPI_ISteamRemoteStorage_FileWrite"
-	flatAPI_ISteamRemoteStorage_FileRead    = "SteamAPI_ISteamRemoteStorage_FileRead"
-	flatAPI_ISteamRemoteStorage_FileDelete  = "SteamAPI_ISteamRemoteStorage_FileDelete"
-	flatAPI_ISteamRemoteStorage_GetFileSize = "SteamAPI_ISteamRemoteStorage_GetFileSize"
-
-	flatAPI_SteamUser             = "SteamAPI_SteamUser_v021"
-	flatAPI_ISteamUser_GetSteamID = "SteamAPI_ISteamUser_GetSteamID"
-
-	flatAPI_SteamUserStats                      = "SteamAPI_SteamUserStats_v012"
-	flatAPI_ISteamUserStats_RequestCurrentStats = "SteamAPI_ISteamUserStats_RequestCurrentStats"
-	flatAPI_ISteamUserStats_GetAchievement      = "SteamAPI_ISteamUserStats_GetAchievement"
-	flatAPI_ISteamUserStats_SetAchievement      = "SteamAPI_ISteamUserStats_SetAchievement"
-	flatAPI_ISteamUserStats_ClearAchievement    = "SteamAPI_ISteamUserStats_ClearAchievement"
-	flatAPI_ISteamUserStats_StoreStats          = "SteamAPI_ISteamUserStats_StoreStats"
-
-	flatAPI_SteamUtils                            = "SteamAPI_SteamUtils_v010"
-	flatAPI_ISteamUtils_IsSteamRunningOnSteamDeck = "SteamAPI_ISteamUtils_IsSteamRunningOnSteamDeck"
-
-	flatAPI_SteamNetworkingMessages                           = "SteamAPI_SteamNetworkingMessages_SteamAPI_v002"
-	flatAPI_ISteamNetworkingMessages_SendMessageToUser        = "SteamAPI_ISteamNetworkingMessages_SendMessageToUser"
-	flatAPI_ISteamNetworkingMessages_ReceiveMessagesOnChannel = "SteamAPI_ISteamNetworkingMessages_ReceiveMessagesOnChannel "
-	flatAPI_ISteamNetworkingMessages_AcceptSessionWithUser    = "SteamAPI_ISteamNetworkingMessages_AcceptSessionWithUser"
-	flatAPI_ISteamNetworkingMessages_CloseSessionWithUser     = "SteamAPI_ISteamNetworkingMessages_CloseSessionWithUser"
-	flatAPI_ISteamNetworkingMessages_CloseChannelWithUser     = "SteamAPI_ISteamNetworkingMessages_CloseChannelWithUser"
-	flatAPI_ISteamNetworkingMessages_GetSessionConnectionInfo = "SteamAPI_ISteamNetworkingMessages_GetSessionConnectionInfo"
-	flatAPI_SteamAPI_SteamNetworkingMessage_t_Release         = "SteamAPI_SteamNetworkingMessage_t_Release"
-
-	flatAPI_SteamMatchmaking                   = "SteamAPI_SteamMatchmaking_v009"
-	flatAPI_ISteamMatchmaking_RequestLobbyList = "SteamAPI_ISteamMatchmaking_RequestLobbyList"
-	flatAPI_ISteamMatchmaking_GetLobbyByIndex  = "SteamAPI_ISteamMatchmaking_GetLobbyByIndex"
-	flatAPI_ISteamMatchmaking_CreateLobby      = "SteamAPI_ISteamMatchmaking_CreateLobby"
-	flatAPI_ISteamMatchmaking_LeaveLobby       = "SteamAPI_ISteamMatchmaking_LeaveLobby"
+// flatAPI identifies a function of the flat Steam API. All of them are resolved when the
+// library is loaded. The identifiers and names are generated by gen.go; the symbols of
+// the interface accessors are overridden by the version bound, see steamInterfaces.
+type flatAPI int
 
+const (
 	SteamAPI_GetHSteamPipe                   = "SteamAPI_GetHSteamPipe"
 	SteamAPI_ManualDispatch_Init             = "SteamAPI_ManualDispatch_Init"
 	SteamAPI_ManualDispatch_RunFrame         = "SteamAPI_ManualDispatch_RunFrame"
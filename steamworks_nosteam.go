@@ -0,0 +1,381 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+//go:build nosteam
+
+// This file implements the package API for builds with the nosteam tag, such as
+// builds for other storefronts. No Steam API library is embedded or loaded, cgo is
+// not required, and every call reports that Steam is not available.
+
+package steamworks
+
+import "unsafe"
+
+func libName() string {
+	return ""
+}
+
+func available() error {
+	return ErrNoSteam
+}
+
+// Shutdown does nothing.
+func Shutdown() error {
+	return nil
+}
+
+func loadedProcs() (*procTable, error) {
+	return nil, ErrNoSteam
+}
+
+func getHSteamPipe() (HSteamPipe, error) {
+	return 0, ErrNoSteam
+}
+
+func manualDispatchInit() error {
+	return ErrNoSteam
+}
+
+func manualDispatchRunFrame(pipe HSteamPipe) error {
+	return ErrNoSteam
+}
+
+func manualDispatchGetNextCallback(pipe HSteamPipe, msg *CallbackMsg_t) (bool, error) {
+	return false, ErrNoSteam
+}
+
+func manualDispatchFreeLastCallback(pipe HSteamPipe) error {
+	return ErrNoSteam
+}
+
+func manualDispatchGetAPICallResult(pipe HSteamPipe, call SteamAPICallbackHandle, data []byte, callbackID int32) (ok, failed bool, err error) {
+	return false, false, ErrNoSteam
+}
+
+// Load reports ErrNoSteam.
+func Load(opts *LoadOptions) error {
+	return ErrNoSteam
+}
+
+func RestartAppIfNecessary(appID uint32) bool {
+	return false
+}
+
+func Init() bool {
+	return false
+}
+
+// InitWithError reports an *InitError wrapping ErrNoSteam.
+func InitWithError() error {
+	return &InitError{Failure: InitFailureLibraryNotLoaded, Err: ErrNoSteam}
+}
+
+func RunCallbacks() {
+}
+
+func SteamAPI_ReleaseCurrentThreadMemory() {}
+func SteamAPI_RunCallbacks()               {}
+
+var (
+	OnDebugMessage   = func(string) {}
+	OnWarningMessage = func(string) {}
+)
+
+func SetWarningMessageHook() {
+}
+
+// The C memory helpers are implemented in Go: the memory they return is managed by
+// the garbage collector, and Free does nothing.
+
+// Malloc returns size bytes of zeroed memory.
+func Malloc(size uintptr) unsafe.Pointer {
+	if size == 0 {
+		return nil
+	}
+	return unsafe.Pointer(&make([]byte, size)[0])
+}
+
+// Free does nothing.
+func Free(ptr unsafe.Pointer) {}
+
+// CChar is a C char.
+type CChar = int8
+
+// CString returns a NUL-terminated copy of str.
+func CString(str string) *CChar {
+	b := make([]byte, len(str)+1)
+	copy(b, str)
+	return (*CChar)(unsafe.Pointer(&b[0]))
+}
+
+// GoString copies the NUL-terminated string at str into a Go string.
+func GoString(str *CChar) string {
+	return goString(uintptr(unsafe.Pointer(str)))
+}
+
+// GoStringN copies maxSize bytes at str into a Go string.
+func GoStringN(str *CChar, maxSize uintptr) string {
+	if str == nil {
+		return ""
+	}
+	return string(unsafe.Slice((*byte)(unsafe.Pointer(str)), maxSize))
+}
+
+func SteamApps() ISteamApps {
+	return steamApps{}
+}
+
+type steamApps struct{}
+
+func (steamApps) BIsSubscribed() bool {
+	return false
+}
+
+func (steamApps) BIsSubscribedApp(appID AppId_t) bool {
+	return false
+}
+
+func (steamApps) BIsLowViolence() bool {
+	return false
+}
+
+func (steamApps) BIsVACBanned() bool {
+	return false
+}
+
+func (steamApps) BIsSubscribedFromFreeWeekend() bool {
+	return false
+}
+
+func (steamApps) BIsSubscribedFromFamilySharing() bool {
+	return false
+}
+
+func (steamApps) GetAppOwner() CSteamID {
+	return 0
+}
+
+func (steamApps) GetEarliestPurchaseUnixTime(appID AppId_t) uint32 {
+	return 0
+}
+
+func (steamApps) GetAppBuildId() int32 {
+	return 0
+}
+
+func (steamApps) GetCurrentBetaName() (name string, ok bool) {
+	return "", false
+}
+
+func (steamApps) GetDLCCount() int32 {
+	return 0
+}
+
+func (steamApps) BGetDLCDataByIndex(iDLC int32) (appID AppId_t, available bool, name string, success bool) {
+	return 0, false, "", false
+}
+
+func (steamApps) BIsDlcInstalled(appID AppId_t) bool {
+	return false
+}
+
+func (steamApps) InstallDLC(appID AppId_t) {
+}
+
+func (steamApps) UninstallDLC(appID AppId_t) {
+}
+
+func (steamApps) GetDlcDownloadProgress(appID AppId_t) (bytesDownloaded, bytesTotal uint64, downloading bool) {
+	return 0, 0, false
+}
+
+func (steamApps) BIsAppInstalled(appID AppId_t) bool {
+	return false
+}
+
+func (steamApps) GetInstalledDepots(appID AppId_t) []DepotId_t {
+	return nil
+}
+
+func (steamApps) GetFileDetails(fileName string) *CallResult[FileDetailsResult_t] {
+	return failedCallResult[FileDetailsResult_t](ErrNoSteam)
+}
+
+func (steamApps) MarkContentCorrupt(missingFilesOnly bool) bool {
+	return false
+}
+
+func (steamApps) GetAppInstallDir(appID AppId_t) string {
+	return ""
+}
+
+func (steamApps) GetCurrentGameLanguage() string {
+	return ""
+}
+
+func (steamApps) GetAvailableGameLanguages() string {
+	return ""
+}
+
+func (steamApps) GetLaunchQueryParam(key string) string {
+	return ""
+}
+
+func (steamApps) GetLaunchCommandLine() string {
+	return ""
+}
+
+func SteamInput() ISteamInput {
+	return steamInput{}
+}
+
+type steamInput struct{}
+
+func (steamInput) GetConnectedControllers() []InputHandle_t {
+	return nil
+}
+
+func (steamInput) GetInputTypeForHandle(inputHandle InputHandle_t) ESteamInputType {
+	return ESteamInputType_Unknown
+}
+
+func (steamInput) Init(bExplicitlyCallRunFrame bool) bool {
+	return false
+}
+
+func (steamInput) RunFrame() {
+}
+
+func SteamRemoteStorage() ISteamRemoteStorage {
+	return steamRemoteStorage{}
+}
+
+type steamRemoteStorage struct{}
+
+func (steamRemoteStorage) FileWrite(file string, data []byte) bool {
+	return false
+}
+
+func (steamRemoteStorage) FileRead(file string, data []byte) int32 {
+	return 0
+}
+
+func (steamRemoteStorage) FileDelete(file string) bool {
+	return false
+}
+
+func (steamRemoteStorage) GetFileSize(file string) int32 {
+	return 0
+}
+
+func SteamUser() ISteamUser {
+	return steamUser{}
+}
+
+type steamUser struct{}
+
+func (steamUser) GetSteamID() CSteamID {
+	return 0
+}
+
+func SteamUserStats() ISteamUserStats {
+	return steamUserStats{}
+}
+
+type steamUserStats struct{}
+
+func (steamUserStats) RequestCurrentStats() bool {
+	return false
+}
+
+func (steamUserStats) GetAchievement(name string) (achieved, success bool) {
+	return false, false
+}
+
+func (steamUserStats) SetAchievement(name string) bool {
+	return false
+}
+
+func (steamUserStats) ClearAchievement(name string) bool {
+	return false
+}
+
+func (steamUserStats) StoreStats() bool {
+	return false
+}
+
+func SteamUtils() ISteamUtils {
+	return steamUtils{}
+}
+
+type steamUtils struct{}
+
+func (steamUtils) IsSteamRunningOnSteamDeck() bool {
+	return false
+}
+
+func (steamUtils) GetAPICallFailureReason(call SteamAPICallbackHandle) ESteamAPICallFailure {
+	return ESteamAPICallFailureSteamGone
+}
+
+func (steamUtils) GetSteamUILanguage() string {
+	return ""
+}
+
+func SteamNetworkingMessages() ISteamNetworkingMessages {
+	return steamNetworkingMessages{}
+}
+
+type steamNetworkingMessages struct{}
+
+func (steamNetworkingMessages) SendMessageToUser(identity SteamNetworkingIdentity, data []byte, sendFlags int32, channel int32) EResult {
+	return EResultFail
+}
+
+func (steamNetworkingMessages) ReceiveMessagesOnChannel(localChannel int32, maxMessages int32) []NetworkingMessage {
+	return nil
+}
+
+func (steamNetworkingMessages) BorrowMessagesOnChannel(localChannel int32, maxMessages int32, pool *MessagePool) []NetworkingMessage {
+	return nil
+}
+
+func (steamNetworkingMessages) AcceptSessionWithUser(identityRemote SteamNetworkingIdentity) bool {
+	return false
+}
+
+func (steamNetworkingMessages) CloseSessionWithUser(identityRemote SteamNetworkingIdentity) bool {
+	return false
+}
+
+func (steamNetworkingMessages) CloseChannelWithUser(identityRemote SteamNetworkingIdentity, nLocalChannel int32) bool {
+	return false
+}
+
+func (steamNetworkingMessages) GetSessionConnectionInfo(identityRemote SteamNetworkingIdentity) (ESteamNetworkingConnectionState, SteamNetConnectionInfo_t, SteamNetConnectionRealTimeStatus_t) {
+	return ESteamNetworkingConnectionState_None, SteamNetConnectionInfo_t{}, SteamNetConnectionRealTimeStatus_t{}
+}
+
+func releaseMessages(ptrs []uintptr) {
+}
+
+func SteamMatchmaking() ISteamMatchmaking {
+	return steamMatchmaking{}
+}
+
+type steamMatchmaking struct{}
+
+func (steamMatchmaking) CreateLobby(eLobbyType ELobbyType, cMaxMembers int32) *CallResult[LobbyCreated_t] {
+	return failedCallResult[LobbyCreated_t](ErrNoSteam)
+}
+
+func (steamMatchmaking) RequestLobbyList() *CallResult[LobbyMatchList_t] {
+	return failedCallResult[LobbyMatchList_t](ErrNoSteam)
+}
+
+func (steamMatchmaking) GetLobbyByIndex(iLobby int32) CSteamID {
+	return 0
+}
+
+func (steamMatchmaking) LeaveLobby(steamIDLobby CSteamID) {
+}
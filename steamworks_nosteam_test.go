@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+//go:build nosteam
+
+package steamworks
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNoSteam(t *testing.T) {
+	if Init() {
+		t.Error("Init succeeded")
+	}
+	if err := InitWithError(); !errors.Is(err, ErrNoSteam) {
+		t.Errorf("InitWithError: got %v, want ErrNoSteam", err)
+	}
+	if _, err := SteamAppsWithError(); !errors.Is(err, ErrNoSteam) {
+		t.Errorf("SteamAppsWithError: got %v, want ErrNoSteam", err)
+	}
+}
+
+func TestNoSteamCStrings(t *testing.T) {
+	p := CString("hello")
+	defer Free(Malloc(16))
+	if got := GoString(p); got != "hello" {
+		t.Errorf("GoString(CString(%q)) = %q", "hello", got)
+	}
+	if got := GoStringN(p, 6); got != "hello\x00" {
+		t.Errorf("GoStringN = %q, want %q", got, "hello\x00")
+	}
+	if got := GoString(nil); got != "" {
+		t.Errorf("GoString(nil) = %q", got)
+	}
+}
@@ -1,479 +1,323 @@
 // SPDX-License-Identifier: Apache-2.0
 // SPDX-FileCopyrightText: 2021 The go-steamworks Authors
 
-//go:build !windows
+//go:build !windows && !nosteam
 
 package steamworks
 
 import (
+	"bytes"
+	"crypto/sha256"
 	_ "embed"
-	"fmt"
+	"encoding/hex"
 	"os"
 	"path/filepath"
 	"runtime"
-	"unsafe"
+	"sync"
+	"sync/atomic"
 )
 
-// #cgo LDFLAGS: -ldl
-//
-// #include <stdbool.h>
-// #include <stdint.h>
-// #include <stdlib.h>
-// #include <dlfcn.h>
-//
-// static uintptr_t dlsym_(uintptr_t handle, const char* name) {
-//   return (uintptr_t)dlsym((void*)handle, name);
-// }
-//
-// static const char* uintptrToChar(uintptr_t str) {
-//   return (const char*)str;
-// }
-//
-// static uint8_t callFunc_Bool(uintptr_t f) {
-//   return ((bool (*)())(f))();
-// }
-//
-// static uint8_t callFunc_Bool_Ptr(uintptr_t f, uintptr_t arg0) {
-//   return ((bool (*)(void*))(f))((void*)arg0);
-// }
-//
-// static uint8_t callFunc_Bool_Ptr_Bool(uintptr_t f, uintptr_t arg0, uint8_t arg1) {
-//   return ((bool (*)(void*, bool))(f))((void*)arg0, (bool)arg1);
-// }
-//
-// static uint8_t callFunc_Bool_Ptr_Ptr(uintptr_t f, uintptr_t arg0, uintptr_t arg1) {
-//   return ((bool (*)(void*, void*))(f))((void*)arg0, (void*)arg1);
-// }
-//
-// static uint8_t callFunc_Bool_Ptr_Ptr_Ptr(uintptr_t f, uintptr_t arg0, uintptr_t arg1, uintptr_t arg2) {
-//   return ((bool (*)(void*, void*, void*))(f))((void*)arg0, (void*)arg1, (void*)arg2);
-// }
-//
-// static uint8_t callFunc_Bool_Ptr_Ptr_Ptr_Int32(uintptr_t f, uintptr_t arg0, uintptr_t arg1, uintptr_t arg2, int32_t arg3) {
-//   return ((bool (*)(void*, void*, void*, int32_t))(f))((void*)arg0, (void*)arg1, (void*)arg2, arg3);
-// }
-//
-// static uint8_t callFunc_Bool_Int32(uintptr_t f, uint32_t arg0) {
-//   return ((bool (*)(uint32_t))(f))(arg0);
-// }
-//
-// static int32_t callFunc_Int32_Ptr(uintptr_t f, uintptr_t arg0) {
-//   return ((int32_t (*)(void*))(f))((void*)arg0);
-// }
-//
-// static int32_t callFunc_Int32_Ptr_Int32_Ptr_Int32(uintptr_t f, uintptr_t arg0, int32_t arg1, uintptr_t arg2, int32_t arg3) {
-//   return ((int32_t (*)(void*, int32_t, void*, int32_t))(f))((void*)arg0, arg1, (void*)arg2, arg3);
-// }
-//
-// static int32_t callFunc_Int32_Ptr_Int64(uintptr_t f, uintptr_t arg0, int64_t arg1) {
-//   return ((int32_t (*)(void*, int64_t))(f))((void*)arg0, arg1);
-// }
-//
-// static int32_t callFunc_Int32_Ptr_Ptr(uintptr_t f, uintptr_t arg0, uintptr_t arg1) {
-//   return ((int32_t (*)(void*, void*))(f))((void*)arg0, (void*)arg1);
-// }
-//
-// static int32_t callFunc_Int32_Ptr_Ptr_Ptr_Int32(uintptr_t f, uintptr_t arg0, uintptr_t arg1, uintptr_t arg2, int32_t arg3) {
-//   return ((int32_t (*)(void*, void*, void*, int32_t))(f))((void*)arg0, (void*)arg1, (void*)arg2, arg3);
-// }
-//
-// static int64_t callFunc_Int64_Ptr(uintptr_t f, uintptr_t arg0) {
-//   return ((int64_t (*)(void*))(f))((void*)arg0);
-// }
-//
-// static uintptr_t callFunc_Ptr(uintptr_t f) {
-//   return (uintptr_t)((void* (*)())(f))();
-// }
-//
-// static uintptr_t callFunc_Ptr_Ptr(uintptr_t f, uintptr_t arg0) {
-//   return (uintptr_t)((void* (*)(void*))(f))((void*)arg0);
-// }
-//
-// static void callFunc_Void(uintptr_t f) {
-//   ((void (*)())(f))();
-// }
-//
-// static void callFunc_Void_Ptr_Bool(uintptr_t f, uintptr_t arg0, uint8_t arg1) {
-//   ((void (*)(void*, bool))(f))((void*)arg0, (bool)arg1);
-// }
-import "C"
-
 type lib struct {
-	lib   C.uintptr_t
-	procs map[string]C.uintptr_t
+	mu        sync.Mutex
+	lib       uintptr
+	err       error
+	extracted string       // path of the library file to remove on close, if any
+	table     atomic.Value // *procTable, stored once the library is loaded
+	calls     int64        // number of calls in progress, accessed atomically
 }
 
-type funcType int
-
-const (
-	funcType_Bool funcType = iota
-	funcType_Bool_Ptr
-	funcType_Bool_Ptr_Bool
-	funcType_Bool_Ptr_Ptr
-	funcType_Bool_Ptr_Ptr_Ptr
-	funcType_Bool_Ptr_Ptr_Ptr_Int32
-	funcType_Bool_Int32
-	funcType_Int32_Int64
-	funcType_Int32_Ptr
-	funcType_Int32_Ptr_Int32_Ptr_Int32
-	funcType_Int32_Ptr_Int64
-	funcType_Int32_Ptr_Ptr
-	funcType_Int32_Ptr_Ptr_Ptr_Int32
-	funcType_Int64_Ptr
-	funcType_Ptr
-	funcType_Ptr_Ptr
-	funcType_Void
-	funcType_Void_Ptr_Bool
-)
+// load loads the library as configured by opts.
+func (l *lib) load(opts *LoadOptions) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
 
-func (l *lib) call(ftype funcType, name string, args ...uintptr) (C.uint64_t, error) {
-	if l.procs == nil {
-		l.procs = map[string]C.uintptr_t{}
-	}
-
-	if _, ok := l.procs[name]; !ok {
-		cname := C.CString(name)
-		defer C.free(unsafe.Pointer(cname))
-		l.procs[name] = C.dlsym_(l.lib, cname)
-	}
-
-	f := l.procs[name]
-	switch ftype {
-	case funcType_Bool:
-		return C.uint64_t(C.callFunc_Bool(f)), nil
-	case funcType_Bool_Ptr:
-		return C.uint64_t(C.callFunc_Bool_Ptr(f, C.uintptr_t(args[0]))), nil
-	case funcType_Bool_Ptr_Bool:
-		return C.uint64_t(C.callFunc_Bool_Ptr_Bool(f, C.uintptr_t(args[0]), C.uint8_t(args[1]))), nil
-	case funcType_Bool_Ptr_Ptr:
-		return C.uint64_t(C.callFunc_Bool_Ptr_Ptr(f, C.uintptr_t(args[0]), C.uintptr_t(args[1]))), nil
-	case funcType_Bool_Ptr_Ptr_Ptr:
-		return C.uint64_t(C.callFunc_Bool_Ptr_Ptr_Ptr(f, C.uintptr_t(args[0]), C.uintptr_t(args[1]), C.uintptr_t(args[2]))), nil
-	case funcType_Bool_Ptr_Ptr_Ptr_Int32:
-		return C.uint64_t(C.callFunc_Bool_Ptr_Ptr_Ptr_Int32(f, C.uintptr_t(args[0]), C.uintptr_t(args[1]), C.uintptr_t(args[2]), C.int32_t(args[3]))), nil
-	case funcType_Bool_Int32:
-		return C.uint64_t(C.callFunc_Bool_Int32(f, C.uint32_t(args[0]))), nil
-	case funcType_Int32_Ptr:
-		return C.uint64_t(C.callFunc_Int32_Ptr(f, C.uintptr_t(args[0]))), nil
-	case funcType_Int32_Ptr_Int32_Ptr_Int32:
-		return C.uint64_t(C.callFunc_Int32_Ptr_Int32_Ptr_Int32(f, C.uintptr_t(args[0]), C.int32_t(args[1]), C.uintptr_t(args[2]), C.int32_t(args[3]))), nil
-	case funcType_Int32_Ptr_Int64:
-		return C.uint64_t(C.callFunc_Int32_Ptr_Int64(f, C.uintptr_t(args[0]), C.int64_t(args[1]))), nil
-	case funcType_Int32_Ptr_Ptr:
-		return C.uint64_t(C.callFunc_Int32_Ptr_Ptr(f, C.uintptr_t(args[0]), C.uintptr_t(args[1]))), nil
-	case funcType_Int32_Ptr_Ptr_Ptr_Int32:
-		return C.uint64_t(C.callFunc_Int32_Ptr_Ptr_Ptr_Int32(f, C.uintptr_t(args[0]), C.uintptr_t(args[1]), C.uintptr_t(args[2]), C.int32_t(args[3]))), nil
-	case funcType_Int64_Ptr:
-		return C.uint64_t(C.callFunc_Int64_Ptr(f, C.uintptr_t(args[0]))), nil
-	case funcType_Ptr:
-		return C.uint64_t(C.callFunc_Ptr(f)), nil
-	case funcType_Ptr_Ptr:
-		return C.uint64_t(C.callFunc_Ptr_Ptr(f, C.uintptr_t(args[0]))), nil
-	case funcType_Void:
-		C.callFunc_Void(f)
-		return 0, nil
-	case funcType_Void_Ptr_Bool:
-		C.callFunc_Void_Ptr_Bool(f, C.uintptr_t(args[0]), C.uint8_t(args[1]))
-		return 0, nil
-	}
-
-	return 0, fmt.Errorf("steamworks: function %s not implemented", name)
+	if l.lib != 0 {
+		return errAlreadyLoaded
+	}
+	l.open(opts)
+	return l.err
 }
 
-func loadLib() (C.uintptr_t, error) {
-	dir, err := os.MkdirTemp("", "")
-	if err != nil {
-		return 0, err
+// open loads the library and resolves its functions. l.mu must be held.
+func (l *lib) open(opts *LoadOptions) {
+	l.lib, l.extracted, l.err = loadLib(opts)
+	if l.err != nil {
+		return
 	}
-
-	ext := ".so"
-	if runtime.GOOS == "darwin" {
-		ext = ".dylib"
+	if opts != nil && opts.KeepExtracted {
+		l.extracted = ""
 	}
-	path := filepath.Join(dir, "libsteam_api"+ext)
-	if err := os.WriteFile(path, libSteamAPI, 0644); err != nil {
-		return 0, err
-	}
-
-	cpath := C.CString(path)
-	defer C.free(unsafe.Pointer(cpath))
+	lib := l.lib
+	l.table.Store(resolveProcs(func(name string) uintptr {
+		return dlsym(lib, name)
+	}))
+}
 
-	lib := C.uintptr_t(uintptr(C.dlopen(cpath, C.RTLD_LAZY)))
-	if lib == 0 {
-		return 0, fmt.Errorf("steamworks: dlopen failed: %s", C.GoString(C.dlerror()))
+// procs returns the resolved functions of the library. It loads the library with the
+// default options unless it is already loaded or a previous attempt failed.
+func (l *lib) procs() (*procTable, error) {
+	if t, _ := l.table.Load().(*procTable); t != nil {
+		return t, nil
 	}
 
-	return lib, nil
-}
-
-var theLib *lib
+	l.mu.Lock()
+	defer l.mu.Unlock()
 
-func init() {
-	l, err := loadLib()
-	if err != nil {
-		panic(err)
+	if l.lib == 0 && l.err == nil {
+		l.open(nil)
 	}
-	theLib = &lib{
-		lib: l,
+	if l.err != nil {
+		return nil, l.err
 	}
+	return l.table.Load().(*procTable), nil
 }
 
-func cBool(x bool) uintptr {
-	if x {
-		return 1
+// inUse reports ErrLibraryInUse if calls into the library are in progress.
+func (l *lib) inUse() error {
+	if atomic.LoadInt64(&l.calls) != 0 {
+		return ErrLibraryInUse
 	}
-	return 0
+	return nil
 }
 
-func RestartAppIfNecessary(appID uint32) bool {
-	v, err := theLib.call(funcType_Bool_Int32, flatAPI_RestartAppIfNecessary, uintptr(appID))
-	if err != nil {
-		panic(err)
-	}
-	return byte(v) != 0
-}
+// close shuts down the Steam API and unloads the library, removing the file extracted
+// for it unless LoadOptions.KeepExtracted was set. The library can be loaded again
+// afterwards.
+//
+// Nothing is done, and ErrLibraryInUse is returned, if calls into the library are in
+// progress on other goroutines, as unloading it would pull the code from under them.
+func (l *lib) close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
 
-func Init() bool {
-	v, err := theLib.call(funcType_Bool, flatAPI_Init)
-	if err != nil {
-		panic(err)
+	if l.lib == 0 {
+		l.err = nil
+		return nil
 	}
-	return byte(v) != 0
-}
 
-func RunCallbacks() {
-	if _, err := theLib.call(funcType_Void, flatAPI_RunCallbacks); err != nil {
-		panic(err)
+	// New calls wait for l.mu once the table is cleared, so only the calls counted
+	// by inUse can still use the library.
+	t, _ := l.table.Load().(*procTable)
+	l.table.Store((*procTable)(nil))
+	if err := l.inUse(); err != nil {
+		l.table.Store(t)
+		return err
 	}
-}
-
-func SteamApps() ISteamApps {
-	v, err := theLib.call(funcType_Ptr, flatAPI_SteamApps)
-	if err != nil {
-		panic(err)
+	if t != nil {
+		if f, err := t.proc(flatAPI_Shutdown); err == nil {
+			callProc(funcType_Void, f)
+		}
 	}
-	return steamApps(v)
-}
 
-type steamApps C.uintptr_t
+	err := dlclose(l.lib)
+	l.lib = 0
+	l.err = nil
 
-func (s steamApps) GetAppInstallDir(appID AppId_t) string {
-	var path [4096]byte
-	v, err := theLib.call(funcType_Int32_Ptr_Int32_Ptr_Int32, flatAPI_ISteamApps_GetAppInstallDir, uintptr(s), uintptr(appID), uintptr(unsafe.Pointer(&path[0])), uintptr(len(path)))
-	if err != nil {
-		panic(err)
+	if l.extracted != "" {
+		os.Remove(l.extracted)
+		os.Remove(filepath.Dir(l.extracted))
+		l.extracted = ""
 	}
-	return string(path[:uint32(v)-1])
+	return err
 }
 
-func (s steamApps) GetCurrentGameLanguage() string {
-	v, err := theLib.call(funcType_Ptr_Ptr, flatAPI_ISteamApps_GetCurrentGameLanguage, uintptr(s))
-	if err != nil {
-		panic(err)
-	}
-	return C.GoString(C.uintptrToChar(C.uintptr_t(v)))
+func (l *lib) ensureLoaded() error {
+	_, err := l.procs()
+	return err
 }
 
-func SteamInput() ISteamInput {
-	v, err := theLib.call(funcType_Ptr, flatAPI_SteamInput)
+func (l *lib) proc(id flatAPI) (uintptr, error) {
+	t, err := l.procs()
 	if err != nil {
-		panic(err)
+		return 0, err
 	}
-	return steamInput(v)
+	return t.proc(id)
 }
 
-type steamInput C.uintptr_t
+// call calls the function id in the library. ftype describes the C signature of the
+// function, which only some backends need.
+//
+//go:uintptrescapes
+func (l *lib) call(ftype funcType, id flatAPI, args ...uintptr) (uint64, error) {
+	atomic.AddInt64(&l.calls, 1)
+	defer atomic.AddInt64(&l.calls, -1)
 
-func (s steamInput) GetConnectedControllers() []InputHandle_t {
-	var handles [_STEAM_INPUT_MAX_COUNT]InputHandle_t
-	v, err := theLib.call(funcType_Int32_Ptr_Ptr, flatAPI_ISteamInput_GetConnectedControllers, uintptr(s), uintptr(unsafe.Pointer(&handles[0])))
+	f, err := l.proc(id)
 	if err != nil {
-		panic(err)
+		return 0, err
 	}
-	return handles[:int(v)]
-}
 
-func (s steamInput) GetInputTypeForHandle(inputHandle InputHandle_t) ESteamInputType {
-	v, err := theLib.call(funcType_Int32_Ptr_Int64, flatAPI_ISteamInput_GetInputTypeForHandle, uintptr(s), uintptr(inputHandle))
-	if err != nil {
-		panic(err)
-	}
-	return ESteamInputType(v)
+	return callProc(ftype, f, args...)
 }
 
-func (s steamInput) Init(bExplicitlyCallRunFrame bool) bool {
-	var callRunFrame uintptr
-	if bExplicitlyCallRunFrame {
-		callRunFrame = 1
+// iface calls the interface accessor id and reports an error if it returns a NULL pointer.
+func (l *lib) iface(id flatAPI) (uint64, error) {
+	t, err := l.procs()
+	if err != nil {
+		return 0, err
 	}
-	v, err := theLib.call(funcType_Bool_Ptr_Bool, flatAPI_ISteamInput_Init, uintptr(s), callRunFrame)
+	v, err := l.call(funcType_Ptr, id)
 	if err != nil {
-		panic(err)
+		return 0, err
 	}
-	return byte(v) != 0
-}
-
-func (s steamInput) RunFrame() {
-	if _, err := theLib.call(funcType_Void_Ptr_Bool, flatAPI_ISteamInput_RunFrame, uintptr(s), 0); err != nil {
-		panic(err)
+	if v == 0 {
+		return 0, interfaceError(t.names[id])
 	}
+	return v, nil
 }
 
-func SteamRemoteStorage() ISteamRemoteStorage {
-	v, err := theLib.call(funcType_Ptr, flatAPI_SteamRemoteStorage)
-	if err != nil {
-		panic(err)
-	}
-	return steamRemoteStorage(v)
+// callFlat calls the function id in the library.
+//
+//go:uintptrescapes
+func callFlat(ftype funcType, id flatAPI, args ...uintptr) (uint64, error) {
+	return theLib.call(ftype, id, args...)
 }
 
-type steamRemoteStorage C.uintptr_t
-
-func (s steamRemoteStorage) FileWrite(file string, data []byte) bool {
-	cfile := C.CString(file)
-	defer C.free(unsafe.Pointer(cfile))
-
-	defer runtime.KeepAlive(data)
-
-	v, err := theLib.call(funcType_Bool_Ptr_Ptr_Ptr_Int32, flatAPI_ISteamRemoteStorage_FileWrite, uintptr(s), uintptr(unsafe.Pointer(cfile)), uintptr(unsafe.Pointer(&data[0])), uintptr(len(data)))
-	if err != nil {
-		panic(err)
-	}
-	return byte(v) != 0
+// flatIface calls the interface accessor id.
+func flatIface(id flatAPI) (uintptr, error) {
+	v, err := theLib.iface(id)
+	return uintptr(v), err
 }
 
-func (s steamRemoteStorage) FileRead(file string, data []byte) int32 {
-	cfile := C.CString(file)
-	defer C.free(unsafe.Pointer(cfile))
-
-	defer runtime.KeepAlive(data)
-
-	v, err := theLib.call(funcType_Int32_Ptr_Ptr_Ptr_Int32, flatAPI_ISteamRemoteStorage_FileRead, uintptr(s), uintptr(unsafe.Pointer(cfile)), uintptr(unsafe.Pointer(&data[0])), uintptr(len(data)))
-	if err != nil {
-		panic(err)
+func libName() string {
+	if runtime.GOOS == "darwin" {
+		return "libsteam_api.dylib"
 	}
-	return int32(v)
+	return "libsteam_api.so"
 }
 
-func (s steamRemoteStorage) FileDelete(file string) bool {
-	cfile := C.CString(file)
-	defer C.free(unsafe.Pointer(cfile))
-
-	v, err := theLib.call(funcType_Bool_Ptr_Ptr, flatAPI_ISteamRemoteStorage_FileDelete, uintptr(s), uintptr(unsafe.Pointer(cfile)))
-	if err != nil {
-		panic(err)
+// loadLib loads the library as configured by opts. If the embedded library had to be
+// extracted to disk, it also returns the path of the extracted file.
+func loadLib(opts *LoadOptions) (lib uintptr, extracted string, err error) {
+	if opts == nil {
+		opts = &LoadOptions{}
 	}
-	return byte(v) != 0
-}
-
-func (s steamRemoteStorage) GetFileSize(file string) int32 {
-	cfile := C.CString(file)
-	defer C.free(unsafe.Pointer(cfile))
 
-	v, err := theLib.call(funcType_Int32_Ptr, flatAPI_ISteamRemoteStorage_GetFileSize, uintptr(s), uintptr(unsafe.Pointer(cfile)))
-	if err != nil {
-		panic(err)
+	if path := findLib(opts); path != "" {
+		lib, err := dlopen(path)
+		return lib, "", err
 	}
-	return int32(v)
-}
 
-func SteamUser() ISteamUser {
-	v, err := theLib.call(funcType_Ptr, flatAPI_SteamUser)
-	if err != nil {
-		panic(err)
+	if !opts.ExtractToDisk {
+		if path, closeFile, err := memfdLib(); err == nil {
+			lib, err := dlopen(path)
+			closeFile()
+			if err == nil {
+				return lib, "", nil
+			}
+		}
 	}
-	return steamUser(v)
-}
-
-type steamUser C.uintptr_t
 
-func (s steamUser) GetSteamID() CSteamID {
-	v, err := theLib.call(funcType_Int64_Ptr, flatAPI_ISteamUser_GetSteamID, uintptr(s))
+	path, err := extractLib(opts.CacheDir)
 	if err != nil {
-		panic(err)
+		return 0, "", err
 	}
-	return CSteamID(v)
-}
-
-func SteamUserStats() ISteamUserStats {
-	v, err := theLib.call(funcType_Ptr, flatAPI_SteamUserStats)
+	lib, err = dlopen(path)
 	if err != nil {
-		panic(err)
+		return 0, "", err
 	}
-	return steamUserStats(v)
+	return lib, path, nil
 }
 
-type steamUserStats C.uintptr_t
-
-func (s steamUserStats) RequestCurrentStats() bool {
-	v, err := theLib.call(funcType_Bool_Ptr, flatAPI_ISteamUserStats_RequestCurrentStats, uintptr(s))
-	if err != nil {
-		panic(err)
+// extractLib writes the embedded library to a directory named after its content hash
+// in cacheDir and returns its path. A file extracted by an earlier run is reused.
+func extractLib(cacheDir string) (string, error) {
+	if cacheDir == "" {
+		dir, err := os.UserCacheDir()
+		if err != nil {
+			dir = os.TempDir()
+		}
+		cacheDir = filepath.Join(dir, "go-steamworks")
 	}
 
-	return byte(v) != 0
-}
+	sum := sha256.Sum256(libSteamAPI)
+	hash := hex.EncodeToString(sum[:8])
+	dir := filepath.Join(cacheDir, hash)
+	path := filepath.Join(dir, libName())
 
-func (s steamUserStats) GetAchievement(name string) (achieved, success bool) {
-	cname := C.CString(name)
-	defer C.free(unsafe.Pointer(cname))
-
-	v, err := theLib.call(funcType_Bool_Ptr_Ptr_Ptr, flatAPI_ISteamUserStats_GetAchievement, uintptr(s), uintptr(unsafe.Pointer(cname)), uintptr(unsafe.Pointer(&achieved)))
-	if err != nil {
-		panic(err)
+	if b, err := os.ReadFile(path); err == nil && bytes.Equal(b, libSteamAPI) {
+		return path, nil
 	}
-	success = byte(v) != 0
 
-	return
-}
-
-func (s steamUserStats) SetAchievement(name string) bool {
-	cname := C.CString(name)
-	defer C.free(unsafe.Pointer(cname))
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
 
-	v, err := theLib.call(funcType_Bool_Ptr_Ptr, flatAPI_ISteamUserStats_SetAchievement, uintptr(s), uintptr(unsafe.Pointer(cname)))
+	// Write to a temporary file first so that a concurrent or interrupted run never
+	// sees a partially written library.
+	f, err := os.CreateTemp(dir, libName()+".*")
 	if err != nil {
-		panic(err)
+		return "", err
+	}
+	tmp := f.Name()
+	if _, err := f.Write(libSteamAPI); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
+		return "", err
+	}
+	if err := os.Chmod(tmp, 0644); err != nil {
+		os.Remove(tmp)
+		return "", err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return "", err
 	}
 
-	return byte(v) != 0
-}
-
-func (s steamUserStats) ClearAchievement(name string) bool {
-	cname := C.CString(name)
-	defer C.free(unsafe.Pointer(cname))
-
-	v, err := theLib.call(funcType_Bool_Ptr_Ptr, flatAPI_ISteamUserStats_ClearAchievement, uintptr(s), uintptr(unsafe.Pointer(cname)))
+	// Remove the libraries extracted for other versions of this package.
+	entries, err := os.ReadDir(cacheDir)
 	if err != nil {
-		panic(err)
+		return path, nil
+	}
+	for _, e := range entries {
+		if !e.IsDir() || e.Name() == hash || len(e.Name()) != len(hash) {
+			continue
+		}
+		if _, err := hex.DecodeString(e.Name()); err != nil {
+			continue
+		}
+		os.RemoveAll(filepath.Join(cacheDir, e.Name()))
 	}
 
-	return byte(v) != 0
+	return path, nil
 }
 
-func (s steamUserStats) StoreStats() bool {
-	v, err := theLib.call(funcType_Bool_Ptr, flatAPI_ISteamUserStats_StoreStats, uintptr(s))
-	if err != nil {
-		panic(err)
-	}
+var theLib = &lib{}
 
-	return byte(v) != 0
+// Load loads the Steam API library as configured by opts, which may be nil.
+//
+// Calling Load is optional: the first call into the Steam API loads the library with
+// the default options. Load reports an error if the library is already loaded.
+func Load(opts *LoadOptions) error {
+	return theLib.load(opts)
 }
 
-func SteamUtils() ISteamUtils {
-	v, err := theLib.call(funcType_Ptr, flatAPI_SteamUtils)
-	if err != nil {
-		panic(err)
-	}
-	return steamUtils(v)
+// available reports an error if the Steam API library cannot be loaded.
+func available() error {
+	return theLib.ensureLoaded()
 }
 
-type steamUtils C.uintptr_t
+// Shutdown stops the callback pump, unregisters all callbacks, shuts the Steam API down
+// and unloads the library. A library file extracted to disk is removed, unless
+// LoadOptions.KeepExtracted is set. The Steam API can be loaded and initialized again
+// afterwards.
+//
+// Calls into the Steam API on other goroutines must have returned before Shutdown is
+// called. If some are still in progress, Shutdown does nothing and returns
+// ErrLibraryInUse, so it can be called again once they have returned.
+func Shutdown() error {
+	if err := theDispatcher.idle(theLib.inUse); err != nil {
+		return err
+	}
+	stopCallbackPump()
+	runShutdownHooks()
+	return theLib.close()
+}
 
-func (s steamUtils) IsSteamRunningOnSteamDeck() bool {
-	v, err := theLib.call(funcType_Bool_Ptr, flatAPI_ISteamUtils_IsSteamRunningOnSteamDeck, uintptr(s))
-	if err != nil {
-		panic(err)
-	}
-	return byte(v) != 0
+func loadedProcs() (*procTable, error) {
+	return theLib.procs()
 }
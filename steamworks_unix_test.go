@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+//go:build !windows && !nosteam
+
+package steamworks
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+)
+
+func TestExtractLib(t *testing.T) {
+	dir := t.TempDir()
+
+	// Directories of other versions are pruned, but nothing else.
+	stale := filepath.Join(dir, "0123456789abcdef")
+	other := filepath.Join(dir, "other")
+	for _, d := range []string{stale, other} {
+		if err := os.MkdirAll(d, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	path, err := extractLib(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256(libSteamAPI)
+	if want := filepath.Join(dir, hex.EncodeToString(sum[:8]), libName()); path != want {
+		t.Errorf("extractLib = %q; want %q", path, want)
+	}
+	if b, err := os.ReadFile(path); err != nil || !bytes.Equal(b, libSteamAPI) {
+		t.Errorf("extracted library differs from the embedded one: %v", err)
+	}
+	if _, err := os.Stat(stale); !os.IsNotExist(err) {
+		t.Errorf("directory of another version not pruned: %v", err)
+	}
+	if _, err := os.Stat(other); err != nil {
+		t.Errorf("unrelated directory pruned: %v", err)
+	}
+
+	// An identical file is reused.
+	before, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := extractLib(dir); err != nil || got != path {
+		t.Fatalf("extractLib again = %q, %v; want %q", got, err, path)
+	}
+	after, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !os.SameFile(before, after) {
+		t.Error("identical library extracted again")
+	}
+
+	// A corrupted file is replaced.
+	if err := os.WriteFile(path, []byte("corrupted"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := extractLib(dir); err != nil || got != path {
+		t.Fatalf("extractLib over a corrupted file = %q, %v; want %q", got, err, path)
+	}
+	if b, err := os.ReadFile(path); err != nil || !bytes.Equal(b, libSteamAPI) {
+		t.Errorf("corrupted library not replaced: %v", err)
+	}
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("extraction left %d files in the hash directory; want 1", len(entries))
+	}
+}
+
+func TestShutdownRemovesExtractedLibrary(t *testing.T) {
+	for _, keep := range []bool{false, true} {
+		dir := t.TempDir()
+		if err := Load(&LoadOptions{CacheDir: dir, ExtractToDisk: true, KeepExtracted: keep}); err != nil {
+			t.Skipf("cannot load the embedded library: %v", err)
+		}
+		path, err := extractLib(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := Shutdown(); err != nil {
+			t.Fatalf("Shutdown: %v", err)
+		}
+		_, err = os.Stat(path)
+		if keep && err != nil {
+			t.Errorf("KeepExtracted: extracted library removed by Shutdown: %v", err)
+		}
+		if !keep && !os.IsNotExist(err) {
+			t.Errorf("extracted library not removed by Shutdown: %v", err)
+		}
+	}
+}
+
+func TestShutdownWithCallsInProgress(t *testing.T) {
+	if err := Load(&LoadOptions{CacheDir: t.TempDir()}); err != nil {
+		t.Skipf("cannot load the embedded library: %v", err)
+	}
+	defer Shutdown()
+
+	var hooked int32
+	onShutdown(func() { atomic.StoreInt32(&hooked, 1) })
+
+	atomic.AddInt64(&theLib.calls, 1)
+	err := Shutdown()
+	atomic.AddInt64(&theLib.calls, -1)
+	if !errors.Is(err, ErrLibraryInUse) {
+		t.Fatalf("Shutdown with a call in progress: got %v, want ErrLibraryInUse", err)
+	}
+	if atomic.LoadInt32(&hooked) != 0 {
+		t.Error("shutdown hooks run by a refused Shutdown")
+	}
+	if _, err := loadedProcs(); err != nil {
+		t.Errorf("library unusable after a refused unload: %v", err)
+	}
+
+	if err := Shutdown(); err != nil {
+		t.Errorf("Shutdown: %v", err)
+	}
+	if theLib.lib != 0 {
+		t.Error("library still loaded after Shutdown")
+	}
+	if atomic.LoadInt32(&hooked) == 0 {
+		t.Error("shutdown hooks not run by Shutdown")
+	}
+}
@@ -1,570 +1,230 @@
 // SPDX-License-Identifier: Apache-2.0
 // SPDX-FileCopyrightText: 2021 The go-steamworks Authors
 
+//go:build !nosteam
+
 package steamworks
 
 import (
-	"errors"
-	"fmt"
-	"runtime"
-	"unsafe"
+	"sync"
+	"sync/atomic"
+	"syscall"
 
 	"golang.org/x/sys/windows"
 )
 
-const is32Bit = unsafe.Sizeof(int(0)) == 4
-
 type dll struct {
+	mu    sync.Mutex
 	d     *windows.LazyDLL
-	procs map[string]*windows.LazyProc
-}
-
-func (d *dll) call(name string, args ...uintptr) (uintptr, error) {
-	if d.procs == nil {
-		d.procs = map[string]*windows.LazyProc{}
-	}
-	if _, ok := d.procs[name]; !ok {
-		d.procs[name] = d.d.NewProc(name)
-	}
-	r, _, err := d.procs[name].Call(args...)
-	if err != nil {
-		errno, ok := err.(windows.Errno)
-		if !ok {
-			return r, err
-		}
-		if errno != 0 {
-			return r, err
-		}
-	}
-	return r, nil
-}
-
-func loadDLL() (*dll, error) {
-	dllName := "steam_api.dll"
-	if !is32Bit {
-		dllName = "steam_api64.dll"
-	}
-
-	return &dll{
-		d: windows.NewLazyDLL(dllName),
-	}, nil
-}
-
-var theDLL *dll
-
-func init() {
-	dll, err := loadDLL()
-	if err != nil {
-		panic(err)
-	}
-	theDLL = dll
+	err   error
+	table atomic.Value // *procTable, stored once the DLL is loaded
+	calls int64        // number of calls in progress, accessed atomically
 }
 
-func RestartAppIfNecessary(appID uint32) bool {
-	v, err := theDLL.call(flatAPI_RestartAppIfNecessary, uintptr(appID))
-	if err != nil {
-		panic(err)
-	}
-	return byte(v) != 0
-}
+// load loads the DLL as configured by opts.
+func (d *dll) load(opts *LoadOptions) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
 
-func Init() bool {
-	v, err := theDLL.call(flatAPI_Init)
-	if err != nil {
-		panic(err)
+	if d.d != nil {
+		return errAlreadyLoaded
 	}
-	go runCallbacksForever()
-	return byte(v) != 0
+	d.open(opts)
+	return d.err
 }
 
-func RunCallbacks() {
-	if _, err := theDLL.call(flatAPI_RunCallbacks); err != nil {
-		panic(err)
+// open loads the DLL and resolves its functions. d.mu must be held.
+func (d *dll) open(opts *LoadOptions) {
+	d.d, d.err = loadDLL(opts)
+	if d.err != nil {
+		return
 	}
-}
-
-func SteamApps() ISteamApps {
-	v, err := theDLL.call(flatAPI_SteamApps)
-	if err != nil {
-		panic(err)
-	}
-	return steamApps(v)
-}
-
-type steamApps uintptr
-
-func (s steamApps) GetAppInstallDir(appID AppId_t) string {
-	var path [4096]byte
-	v, err := theDLL.call(flatAPI_ISteamApps_GetAppInstallDir, uintptr(s), uintptr(appID), uintptr(unsafe.Pointer(&path[0])), uintptr(len(path)))
-	if err != nil {
-		panic(err)
-	}
-	return string(path[:uint32(v)-1])
-}
-
-func (s steamApps) GetCurrentGameLanguage() string {
-	v, err := theDLL.call(flatAPI_ISteamApps_GetCurrentGameLanguage, uintptr(s))
-	if err != nil {
-		panic(err)
-	}
-
-	bs := make([]byte, 0, 256)
-	for i := int32(0); ; i++ {
-		b := *(*byte)(unsafe.Pointer(v))
-		v += unsafe.Sizeof(byte(0))
-		if b == 0 {
-			break
+	lib := d.d
+	d.table.Store(resolveProcs(func(name string) uintptr {
+		p := lib.NewProc(name)
+		if p.Find() != nil {
+			return 0
 		}
-		bs = append(bs, b)
-	}
-	return string(bs)
-}
-
-func SteamInput() ISteamInput {
-	v, err := theDLL.call(flatAPI_SteamInput)
-	if err != nil {
-		panic(err)
-	}
-	return steamInput(v)
-}
-
-type steamInput uintptr
-
-func (s steamInput) GetConnectedControllers() []InputHandle_t {
-	var handles [_STEAM_INPUT_MAX_COUNT]InputHandle_t
-	v, err := theDLL.call(flatAPI_ISteamInput_GetConnectedControllers, uintptr(s), uintptr(unsafe.Pointer(&handles[0])))
-	if err != nil {
-		panic(err)
-	}
-	return handles[:int(v)]
-}
-
-func (s steamInput) GetInputTypeForHandle(inputHandle InputHandle_t) ESteamInputType {
-	v, err := theDLL.call(flatAPI_ISteamInput_GetInputTypeForHandle, uintptr(s), uintptr(inputHandle))
-	if err != nil {
-		panic(err)
-	}
-	return ESteamInputType(v)
-}
-
-func (s steamInput) Init(bExplicitlyCallRunFrame bool) bool {
-	var callRunFrame uintptr
-	if bExplicitlyCallRunFrame {
-		callRunFrame = 1
-	}
-	// The error value seems unreliable.
-	v, _ := theDLL.call(flatAPI_ISteamInput_Init, uintptr(s), callRunFrame)
-	return byte(v) != 0
-}
-
-func (s steamInput) RunFrame() {
-	if _, err := theDLL.call(flatAPI_ISteamInput_RunFrame, uintptr(s), 0); err != nil {
-		panic(err)
-	}
-}
-
-func SteamRemoteStorage() ISteamRemoteStorage {
-	v, err := theDLL.call(flatAPI_SteamRemoteStorage)
-	if err != nil {
-		panic(err)
-	}
-	return steamRemoteStorage(v)
-}
-
-type steamRemoteStorage uintptr
-
-func (s steamRemoteStorage) FileWrite(file string, data []byte) bool {
-	cfile := append([]byte(file), 0)
-	defer runtime.KeepAlive(cfile)
-
-	defer runtime.KeepAlive(data)
-
-	v, err := theDLL.call(flatAPI_ISteamRemoteStorage_FileWrite, uintptr(s), uintptr(unsafe.Pointer(&cfile[0])), uintptr(unsafe.Pointer(&data[0])), uintptr(len(data)))
-	if err != nil {
-		panic(err)
-	}
-
-	return byte(v) != 0
-}
-
-func (s steamRemoteStorage) FileRead(file string, data []byte) int32 {
-	cfile := append([]byte(file), 0)
-	defer runtime.KeepAlive(cfile)
-
-	defer runtime.KeepAlive(data)
-
-	v, err := theDLL.call(flatAPI_ISteamRemoteStorage_FileRead, uintptr(s), uintptr(unsafe.Pointer(&cfile[0])), uintptr(unsafe.Pointer(&data[0])), uintptr(len(data)))
-	if err != nil {
-		panic(err)
-	}
-
-	return int32(v)
-}
-
-func (s steamRemoteStorage) FileDelete(file string) bool {
-	cfile := append([]byte(file), 0)
-	defer runtime.KeepAlive(cfile)
-
-	v, err := theDLL.call(flatAPI_ISteamRemoteStorage_FileDelete, uintptr(s), uintptr(unsafe.Pointer(&cfile[0])))
-	if err != nil {
-		panic(err)
-	}
-
-	return byte(v) != 0
-}
-
-func (s steamRemoteStorage) GetFileSize(file string) int32 {
-	cfile := append([]byte(file), 0)
-	defer runtime.KeepAlive(cfile)
-
-	v, err := theDLL.call(flatAPI_ISteamRemoteStorage_GetFileSize, uintptr(s), uintptr(unsafe.Pointer(&cfile[0])))
-	if err != nil {
-		panic(err)
-	}
-
-	return int32(v)
-}
-
-func SteamUser() ISteamUser {
-	v, err := theDLL.call(flatAPI_SteamUser)
-	if err != nil {
-		panic(err)
-	}
-	return steamUser(v)
-}
-
-type steamUser uintptr
-
-func (s steamUser) GetSteamID() CSteamID {
-	if is32Bit {
-		// On 32bit machines, syscall cannot treat a returned value as 64bit.
-		panic("GetSteamID is not implemented on 32bit Windows")
-	}
-	v, err := theDLL.call(flatAPI_ISteamUser_GetSteamID, uintptr(s))
-	if err != nil {
-		panic(err)
-	}
-	return CSteamID(v)
+		return p.Addr()
+	}))
 }
 
-func SteamUserStats() ISteamUserStats {
-	v, err := theDLL.call(flatAPI_SteamUserStats)
-	if err != nil {
-		panic(err)
+// procs returns the resolved functions of the DLL. It loads the DLL with the default
+// options unless it is already loaded or a previous attempt failed.
+func (d *dll) procs() (*procTable, error) {
+	if t, _ := d.table.Load().(*procTable); t != nil {
+		return t, nil
 	}
-	return steamUserStats(v)
-}
 
-type steamUserStats uintptr
+	d.mu.Lock()
+	defer d.mu.Unlock()
 
-func (s steamUserStats) RequestCurrentStats() bool {
-	v, err := theDLL.call(flatAPI_ISteamUserStats_RequestCurrentStats, uintptr(s))
-	if err != nil {
-		panic(err)
+	if d.d == nil && d.err == nil {
+		d.open(nil)
 	}
-
-	return byte(v) != 0
-}
-
-func (s steamUserStats) GetAchievement(name string) (achieved, success bool) {
-	cname := append([]byte(name), 0)
-	defer runtime.KeepAlive(cname)
-
-	v, err := theDLL.call(flatAPI_ISteamUserStats_SetAchievement, uintptr(s), uintptr(unsafe.Pointer(&cname[0])), uintptr(unsafe.Pointer(&achieved)))
-	if err != nil {
-		panic(err)
+	if d.err != nil {
+		return nil, d.err
 	}
-
-	success = byte(v) != 0
-	return
+	return d.table.Load().(*procTable), nil
 }
 
-func (s steamUserStats) SetAchievement(name string) bool {
-	cname := append([]byte(name), 0)
-	defer runtime.KeepAlive(cname)
-
-	v, err := theDLL.call(flatAPI_ISteamUserStats_SetAchievement, uintptr(s), uintptr(unsafe.Pointer(&cname[0])))
-	if err != nil {
-		panic(err)
+// inUse reports ErrLibraryInUse if calls into the DLL are in progress.
+func (d *dll) inUse() error {
+	if atomic.LoadInt64(&d.calls) != 0 {
+		return ErrLibraryInUse
 	}
-
-	return byte(v) != 0
+	return nil
 }
 
-func (s steamUserStats) ClearAchievement(name string) bool {
-	cname := append([]byte(name), 0)
-	defer runtime.KeepAlive(cname)
+// close shuts down the Steam API and unloads the DLL. The DLL can be loaded again afterwards.
+//
+// Nothing is done, and ErrLibraryInUse is returned, if calls into the DLL are in
+// progress on other goroutines, as unloading it would pull the code from under them.
+func (d *dll) close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
 
-	v, err := theDLL.call(flatAPI_ISteamUserStats_ClearAchievement, uintptr(s), uintptr(unsafe.Pointer(&cname[0])))
-	if err != nil {
-		panic(err)
+	if d.d == nil {
+		d.err = nil
+		return nil
 	}
 
-	return byte(v) != 0
-}
-
-func (s steamUserStats) StoreStats() bool {
-	v, err := theDLL.call(flatAPI_ISteamUserStats_StoreStats, uintptr(s))
-	if err != nil {
-		panic(err)
+	// New calls wait for d.mu once the table is cleared, so only the calls counted
+	// by inUse can still use the DLL.
+	t, _ := d.table.Load().(*procTable)
+	d.table.Store((*procTable)(nil))
+	if err := d.inUse(); err != nil {
+		d.table.Store(t)
+		return err
 	}
-
-	return byte(v) != 0
-}
-
-func SteamUtils() ISteamUtils {
-	v, err := theDLL.call(flatAPI_SteamUtils)
-	if err != nil {
-		panic(err)
-	}
-	return steamUtils(v)
-}
-
-type steamUtils uintptr
-
-func (s steamUtils) IsSteamRunningOnSteamDeck() bool {
-	v, err := theDLL.call(flatAPI_ISteamUtils_IsSteamRunningOnSteamDeck, uintptr(s))
-	if err != nil {
-		panic(err)
+	if t != nil {
+		if f, err := t.proc(flatAPI_Shutdown); err == nil {
+			syscall.SyscallN(f)
+		}
 	}
 
-	return byte(v) != 0
+	err := windows.FreeLibrary(windows.Handle(d.d.Handle()))
+	d.d = nil
+	d.err = nil
+	return err
 }
 
-type steamNetworkingMessages uintptr
-
-func SteamNetworkingMessages() ISteamNetworkingMessages {
-	v, err := theDLL.call(flatAPI_SteamNetworkingMessages)
-	if err != nil {
-		panic(err)
-	}
-	return steamNetworkingMessages(v)
+func (d *dll) ensureLoaded() error {
+	_, err := d.procs()
+	return err
 }
 
-func (s steamNetworkingMessages) SendMessageToUser(identity SteamNetworkingIdentity, data []byte, sendFlags int32, channel int32) EResult {
-	cID := uintptr(unsafe.Pointer(&identity))
-	cData := uintptr(unsafe.Pointer(&data[0]))
-	cLen := uintptr(len(data))
-	cSendFlags := uintptr(sendFlags)
-	cChannel := uintptr(channel)
-
-	v, err := theDLL.call(flatAPI_ISteamNetworkingMessages_SendMessageToUser, uintptr(s), cID, cData, cLen, cSendFlags, cChannel)
+func (d *dll) proc(id flatAPI) (uintptr, error) {
+	t, err := d.procs()
 	if err != nil {
-		panic(err)
+		return 0, err
 	}
-	return EResult(v)
+	return t.proc(id)
 }
 
-func (s steamNetworkingMessages) ReceiveMessagesOnChannel(localChannel int32, maxMessages int32) ([]SteamNetworkingMessage_t, EResult) {
-	cLocalChannel := uintptr(localChannel)
-	cMaxMessages := uintptr(maxMessages)
-	data := make([]SteamNetworkingMessage_t, maxMessages)
-	cData := uintptr(unsafe.Pointer(&data[0]))
+// call calls the function id in the DLL. On 32-bit Windows, a 64-bit result is
+// returned in EDX:EAX, so ftype tells whether to combine the two registers.
+//
+//go:uintptrescapes
+func (d *dll) call(ftype funcType, id flatAPI, args ...uintptr) (uint64, error) {
+	atomic.AddInt64(&d.calls, 1)
+	defer atomic.AddInt64(&d.calls, -1)
 
-	v, err := theDLL.call(flatAPI_ISteamNetworkingMessages_ReceiveMessagesOnChannel, uintptr(s), cLocalChannel, cData, cMaxMessages)
+	f, err := d.proc(id)
 	if err != nil {
-		panic(err)
+		return 0, err
 	}
-	result := EResult(v)
-	switch result {
-	case EResultOK:
-		return data, result
-	default:
-		return nil, result
+	r1, r2, errno := syscall.SyscallN(f, args...)
+	v := uint64(r1)
+	if is32Bit && ftype.returnsInt64() {
+		v = uint64(r2)<<32 | uint64(uint32(r1))
 	}
-}
-
-func (s steamNetworkingMessages) AcceptSessionWithUser(identityRemote SteamNetworkingIdentity) bool {
-	v, err := theDLL.call(flatAPI_ISteamNetworkingMessages_AcceptSessionWithUser, uintptr(s), uintptr(unsafe.Pointer(&identityRemote)))
-	if err != nil {
-		panic(err)
+	if errno != 0 {
+		return v, errno
 	}
-	return byte(v) != 0
+	return v, nil
 }
 
-func (s steamNetworkingMessages) CloseSessionWithUser(identityRemote SteamNetworkingIdentity) bool {
-	v, err := theDLL.call(flatAPI_ISteamNetworkingMessages_CloseSessionWithUser, uintptr(s), uintptr(unsafe.Pointer(&identityRemote)))
+// iface calls the interface accessor id and reports an error if it returns a NULL pointer.
+func (d *dll) iface(id flatAPI) (uintptr, error) {
+	t, err := d.procs()
 	if err != nil {
-		panic(err)
+		return 0, err
 	}
-	return byte(v) != 0
-
-}
-
-func (s steamNetworkingMessages) CloseChannelWithUser(identityRemote SteamNetworkingIdentity, nLocalChannel int32) bool {
-	v, err := theDLL.call(flatAPI_ISteamNetworkingMessages_CloseChannelWithUser, uintptr(s), uintptr(unsafe.Pointer(&identityRemote)), uintptr(nLocalChannel))
+	v, err := d.call(funcType_Ptr, id)
 	if err != nil {
-		panic(err)
+		return 0, err
 	}
-	return byte(v) != 0
-}
-
-func (s steamNetworkingMessages) GetSessionConnectionInfo(identityRemote SteamNetworkingIdentity) (ESteamNetworkingConnectionState, SteamNetConnectionInfo_t, SteamNetConnectionRealTimeStatus_t) {
-	info := SteamNetConnectionInfo_t{}
-	stats := SteamNetConnectionRealTimeStatus_t{}
-
-	v, err := theDLL.call(flatAPI_ISteamNetworkingMessages_GetSessionConnectionInfo, uintptr(s), uintptr(unsafe.Pointer(&identityRemote)), uintptr(unsafe.Pointer(&info)), uintptr(unsafe.Pointer(&stats)))
-	if err != nil {
-		panic(err)
+	if v == 0 {
+		return 0, interfaceError(t.names[id])
 	}
-	return ESteamNetworkingConnectionState(v), info, stats
+	return uintptr(v), nil
 }
 
-func ReleaseMessages(messages []SteamNetworkingMessage_t) {
-	for i := 0; i < len(messages); i++ {
-		_, err := theDLL.call(flatAPI_SteamAPI_SteamNetworkingMessage_t_Release, uintptr(unsafe.Pointer(&messages[i])))
-		if err != nil {
-			panic(err)
-		}
-	}
+// callFlat calls the function id in the DLL.
+//
+//go:uintptrescapes
+func callFlat(ftype funcType, id flatAPI, args ...uintptr) (uint64, error) {
+	return theDLL.call(ftype, id, args...)
 }
 
-type steamMatchmaking uintptr
-
-func SteamMatchmaking() ISteamMatchmaking {
-	v, err := theDLL.call(flatAPI_SteamMatchmaking)
-	if err != nil {
-		panic(err)
-	}
-	return steamMatchmaking(v)
+// flatIface calls the interface accessor id.
+func flatIface(id flatAPI) (uintptr, error) {
+	return theDLL.iface(id)
 }
 
-func (s steamMatchmaking) CreateLobby(eLobbyType ELobbyType, cMaxMembers int32) (msg LobbyCreated_t, err error) {
-	v, err := theDLL.call(flatAPI_ISteamMatchmaking_CreateLobby, uintptr(s), uintptr(eLobbyType), uintptr(cMaxMembers))
-	if err != nil {
-		panic(err)
-	}
-	apiHandle := SteamAPICallbackHandle(v)
-
-	registerCallback(func(p unsafe.Pointer, u uintptr, b bool, sa SteamAPICall) {
-		created := (*LobbyCreated)(p)
-		fmt.Println("Lobby Created: ")
-		fmt.Printf("Result: %d\n", created.EResult)
-		fmt.Printf("SteamIDLobby: %d\n", created.UlSteamIDLobby.value[0])
-
-	}, uintptr(unsafe.Sizeof(LobbyCreated{})),
-		int32(k_iSteamAPICallbackLobbyCreated), SteamAPICall(apiHandle), false)
-
-	registerCallback(func(p unsafe.Pointer, u uintptr, b bool, sa SteamAPICall) {
-		created := (*LobbyEnter)(p)
-		fmt.Println("Lobby Enter: ")
-		fmt.Printf("ChatPermissions: %d\n", created.RgfChatPermissions)
-		fmt.Printf("EChatRoomEnterResponse: %d\n", created.EChatRoomEnterResponse)
-		fmt.Printf("SteamIDLobby: %d\n", created.UlSteamIDLobby.value[0])
-		fmt.Printf("Locked: %v\n", created.BLocked)
-
-	}, uintptr(unsafe.Sizeof(LobbyEnter{})),
-		int32(k_iSteamAPICallbackLobbyEnter), SteamAPICall(apiHandle), false)
-	return msg, nil
-}
-
-func (s steamMatchmaking) RequestLobbyList() (list LobbyMatchList_t, err error) {
-	v, err := theDLL.call(flatAPI_ISteamMatchmaking_RequestLobbyList, uintptr(s))
-	if err != nil {
-		panic(err)
-	}
-	apiHandle := SteamAPICallbackHandle(v)
-	data := getAPICallResult(apiHandle)
-	if data != nil {
-		created := (*LobbyMatchList_t)(unsafe.Pointer(&data[0]))
-		return *created, nil
-	} else {
-		return list, errors.New("could not complete RequestLobbyList call")
-	}
-}
-
-func (s steamMatchmaking) LeaveLobby(steamIDLobby CSteamID) {
-	_, err := theDLL.call(flatAPI_ISteamMatchmaking_LeaveLobby, uintptr(s), uintptr(steamIDLobby))
-	if err != nil {
-		panic(err)
-	}
-}
-
-func (s steamMatchmaking) GetLobbyByIndex(iLobby int32) CSteamID {
-	v, err := theDLL.call(flatAPI_ISteamMatchmaking_GetLobbyByIndex, uintptr(s), uintptr(iLobby))
-	if err != nil {
-		panic(err)
+func libName() string {
+	if is32Bit {
+		return "steam_api.dll"
 	}
-	return CSteamID(v)
+	return "steam_api64.dll"
 }
 
-func getHSteamPipe() HSteamPipe {
-	v, err := theDLL.call(SteamAPI_GetHSteamPipe)
-	if err != nil {
-		panic(err)
+func loadDLL(opts *LoadOptions) (*windows.LazyDLL, error) {
+	path := findLib(opts)
+	if path == "" {
+		path = libName()
 	}
-	return HSteamPipe(v)
-}
 
-func manualDispatch_Init() {
-	_, err := theDLL.call(SteamAPI_ManualDispatch_Init)
-	if err != nil {
-		panic(err)
+	d := windows.NewLazyDLL(path)
+	if err := d.Load(); err != nil {
+		return nil, err
 	}
+	return d, nil
 }
 
-func manualDispatch_RunFrame(hSteamPipe HSteamPipe) {
-	_, err := theDLL.call(SteamAPI_ManualDispatch_RunFrame)
-	if err != nil {
-		panic(err)
-	}
+var theDLL = &dll{}
 
+// Load loads the Steam API DLL as configured by opts, which may be nil.
+// As no DLL is embedded on Windows, the default is to let Windows search for steam_api.dll
+// or steam_api64.dll.
+//
+// Calling Load is optional: the first call into the Steam API loads the DLL with
+// the default options. Load reports an error if the DLL is already loaded.
+func Load(opts *LoadOptions) error {
+	return theDLL.load(opts)
 }
 
-func manualDispatch_GetNextCallback(hSteamPipe HSteamPipe) (CallbackMsg_t, bool) {
-	var callback CallbackMsg_t
-	v, err := theDLL.call(SteamAPI_ManualDispatch_GetNextCallback, uintptr(hSteamPipe), uintptr(unsafe.Pointer(&callback)))
-	if err != nil {
-		panic(err)
+// Shutdown stops the callback pump, unregisters all callbacks, shuts the Steam API down
+// and unloads the DLL. The Steam API can be loaded and initialized again afterwards.
+//
+// Calls into the Steam API on other goroutines must have returned before Shutdown is
+// called. If some are still in progress, Shutdown does nothing and returns
+// ErrLibraryInUse, so it can be called again once they have returned.
+func Shutdown() error {
+	if err := theDispatcher.idle(theDLL.inUse); err != nil {
+		return err
 	}
-	return callback, v != 0
+	stopCallbackPump()
+	runShutdownHooks()
+	return theDLL.close()
 }
 
-func manualDispatch_FreeLastCallback(hSteamPipe HSteamPipe) {
-	_, err := theDLL.call(SteamAPI_ManualDispatch_FreeLastCallback)
-	if err != nil {
-		panic(err)
-	}
-
+func loadedProcs() (*procTable, error) {
+	return theDLL.procs()
 }
 
-func manualDispatch_GetAPICallResult(hSteamPipe HSteamPipe, hSteamAPICall SteamAPICallbackHandle,
-	cubCallback int32, iCallbackExpected int32) ([]byte, bool) {
-	var failed int
-	data := make([]byte, cubCallback)
-
-	v, err := theDLL.call(SteamAPI_ManualDispatch_GetAPICallResult,
-		uintptr(hSteamPipe), uintptr(hSteamAPICall),
-		uintptr(unsafe.Pointer(&data[0])), uintptr(cubCallback), uintptr(iCallbackExpected), uintptr(failed))
-	if err != nil {
-		panic(err)
-	}
-	return data, byte(v) != 0
-
-}
-
-func getAPICallResult(handle SteamAPICallbackHandle) []byte {
-	hSteamPipe := getHSteamPipe()
-	manualDispatch_RunFrame(hSteamPipe)
-	for {
-		callback, available := manualDispatch_GetNextCallback(hSteamPipe)
-		if !available {
-			break
-		}
-
-		if callback.m_iCallback == int32(k_iSteamAPICallbackCallCompleted) {
-			pCallCompleted := (*SteamAPICallCompleted_t)(unsafe.Pointer(callback.m_pubParam))
-			if pCallCompleted.m_hAsyncCall != handle {
-				continue
-			}
-
-			if data, success := manualDispatch_GetAPICallResult(hSteamPipe, pCallCompleted.m_hAsyncCall, callback.m_cubParam, callback.m_iCallback); success {
-				manualDispatch_FreeLastCallback(hSteamPipe)
-				return data
-			}
-		}
-
-		manualDispatch_FreeLastCallback(hSteamPipe)
-	}
-	return nil
+// available reports an error if the Steam API DLL cannot be loaded.
+func available() error {
+	return theDLL.ensureLoaded()
 }
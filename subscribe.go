@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"fmt"
+	"reflect"
+	"sync/atomic"
+	"unsafe"
+)
+
+// CallbackStruct is implemented by the Go types of Steam callback structs. Their fields
+// mirror the fields of the C struct in order; the C layout is derived from them with the
+// packing the Steam API uses on the target platform.
+type CallbackStruct interface {
+	// CallbackID returns the k_iCallback value of the struct.
+	CallbackID() int32
+}
+
+func callbackLayout[T CallbackStruct]() *cLayout {
+	var zero T
+	l, err := layoutOf(reflect.TypeOf(zero))
+	if err != nil {
+		panic(err)
+	}
+	return l
+}
+
+// CallbackSize returns the size of the C struct corresponding to T on the target platform.
+func CallbackSize[T CallbackStruct]() int {
+	return int(callbackLayout[T]().size)
+}
+
+// decodeCallback copies the C struct in data to a new T.
+func decodeCallback[T CallbackStruct](l *cLayout, data []byte) (*T, error) {
+	v := new(T)
+	if err := l.decode(unsafe.Pointer(v), data); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// CallbackError reports a callback that Subscribe dropped because its payload could not
+// be decoded into the Go type of the handler.
+type CallbackError struct {
+	CallbackID int32
+	Type       string // the Go type of the handler
+	Err        error
+}
+
+func (e *CallbackError) Error() string {
+	return fmt.Sprintf("steamworks: dropped callback %d for %s: %v", e.CallbackID, e.Type, e.Err)
+}
+
+func (e *CallbackError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	droppedCallbacks     uint64
+	callbackErrorHandler atomic.Value // func(error)
+)
+
+// SetCallbackErrorHandler sets f to be called with a *CallbackError for every callback
+// that Subscribe drops, and with a *PumpPanicError when a panic stops a CallbackPump.
+// f runs during RunCallbacks. A nil f, the default, drops the callbacks silently.
+func SetCallbackErrorHandler(f func(err error)) {
+	callbackErrorHandler.Store(f)
+}
+
+// DroppedCallbacks returns the number of callbacks that Subscribe has dropped so far,
+// including the call completions whose payload could not be decoded.
+func DroppedCallbacks() uint64 {
+	return atomic.LoadUint64(&droppedCallbacks)
+}
+
+func dropCallback(err *CallbackError) {
+	atomic.AddUint64(&droppedCallbacks, 1)
+	reportCallbackError(err)
+}
+
+// reportCallbackError passes err to the handler set by SetCallbackErrorHandler, if any.
+func reportCallbackError(err error) {
+	if f, _ := callbackErrorHandler.Load().(func(error)); f != nil {
+		f(err)
+	}
+}
+
+// Subscribe registers f to be called with every callback of type T. The payload is
+// copied into Go memory before f runs, so f may keep it. f runs during RunCallbacks.
+//
+// Subscribe panics if T has a field without a fixed C layout, such as an int. A payload
+// whose size does not match T is dropped instead of being misread, and reported as
+// described by SetCallbackErrorHandler.
+func Subscribe[T CallbackStruct](f func(*T)) Subscription {
+	var zero T
+	l := callbackLayout[T]()
+	return SubscribeRaw(zero.CallbackID(), func(data []byte) {
+		v, err := decodeCallback[T](l, data)
+		if err != nil {
+			dropCallback(&CallbackError{
+				CallbackID: zero.CallbackID(),
+				Type:       reflect.TypeOf(zero).String(),
+				Err:        err,
+			})
+			return
+		}
+		f(v)
+	})
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSubscribeDropsMismatchedPayload(t *testing.T) {
+	defer theDispatcher.reset()
+	defer SetCallbackErrorHandler(nil)
+
+	var got error
+	SetCallbackErrorHandler(func(err error) {
+		got = err
+	})
+
+	called := false
+	sub := Subscribe(func(*DlcInstalled_t) {
+		called = true
+	})
+	defer sub.Unsubscribe()
+
+	dropped := DroppedCallbacks()
+	theDispatcher.dispatch(CallbackID_DlcInstalled_t, make([]byte, CallbackSize[DlcInstalled_t]()+1))
+
+	if called {
+		t.Error("handler called with a payload of the wrong size")
+	}
+	if n := DroppedCallbacks() - dropped; n != 1 {
+		t.Errorf("DroppedCallbacks increased by %d, want 1", n)
+	}
+	var cerr *CallbackError
+	if !errors.As(got, &cerr) || cerr.CallbackID != CallbackID_DlcInstalled_t {
+		t.Errorf("error handler got %v, want a *CallbackError for DlcInstalled_t", got)
+	}
+}
+
+func TestSubscribeDecodesPayload(t *testing.T) {
+	defer theDispatcher.reset()
+
+	var got AppId_t
+	sub := Subscribe(func(e *DlcInstalled_t) {
+		got = e.AppID
+	})
+	defer sub.Unsubscribe()
+
+	theDispatcher.dispatch(CallbackID_DlcInstalled_t, []byte{0x40, 0xe2, 0x01, 0x00})
+
+	if got != 123456 {
+		t.Errorf("AppID = %d, want 123456", got)
+	}
+}
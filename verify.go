@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"context"
+	"crypto/sha1"
+	"errors"
+	"io"
+	"os"
+)
+
+// ErrFileMissing is reported by VerifyFiles for a file of the depots that does not exist
+// locally.
+var ErrFileMissing = errors.New("steamworks: file is missing")
+
+// ErrFileModified is reported by VerifyFiles for a local file whose size or SHA-1 hash
+// differs from the depots.
+var ErrFileModified = errors.New("steamworks: file differs from the depot")
+
+// FileVerification is the result of checking a local file against the depots of the app.
+type FileVerification struct {
+	Path string
+
+	// Size and SHA1 are the size and the hash of the file as Steam knows them.
+	Size uint64
+	SHA1 [sha1.Size]byte
+
+	// Err is nil if the local file matches. It is ErrFileMissing or ErrFileModified if
+	// the file does not, the EResult of Steam if Steam could not report the details of
+	// the file, for example EResultFileNotFound for a file in no depot, or another error
+	// if the file could not be checked.
+	Err error
+}
+
+// VerifyResult is the result of VerifyFiles.
+type VerifyResult struct {
+	Files []FileVerification
+}
+
+// Corrupt returns the files that are missing or modified.
+func (r *VerifyResult) Corrupt() []FileVerification {
+	var files []FileVerification
+	for _, f := range r.Files {
+		if errors.Is(f.Err, ErrFileMissing) || errors.Is(f.Err, ErrFileModified) {
+			files = append(files, f)
+		}
+	}
+	return files
+}
+
+// MarkContentCorrupt asks Steam to verify the installation of the app on its next
+// launch if any file is corrupt, and reports whether it did. Only missing files are
+// checked by Steam if no file is modified.
+func (r *VerifyResult) MarkContentCorrupt(apps ISteamApps) bool {
+	corrupt := r.Corrupt()
+	if len(corrupt) == 0 {
+		return false
+	}
+	missingOnly := true
+	for _, f := range corrupt {
+		if !errors.Is(f.Err, ErrFileMissing) {
+			missingOnly = false
+			break
+		}
+	}
+	return apps.MarkContentCorrupt(missingOnly)
+}
+
+// VerifyFiles checks the local files at paths against the sizes and the SHA-1 hashes
+// Steam reports for them with GetFileDetails. paths are the absolute paths of files of
+// the installation of the app, for example under GetAppInstallDir.
+//
+// The details arrive as call results, so callbacks must be pumped while VerifyFiles
+// waits for them. VerifyFiles returns an error only if ctx is done first, in which case
+// the results still pending are discarded.
+func VerifyFiles(ctx context.Context, apps ISteamApps, paths []string) (*VerifyResult, error) {
+	calls := make([]*CallResult[FileDetailsResult_t], len(paths))
+	for i, path := range paths {
+		calls[i] = apps.GetFileDetails(path)
+	}
+
+	r := &VerifyResult{
+		Files: make([]FileVerification, len(paths)),
+	}
+	for i, path := range paths {
+		f := &r.Files[i]
+		f.Path = path
+
+		details, err := calls[i].Await(ctx)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				for _, c := range calls[i+1:] {
+					c.abandon(ctxErr)
+				}
+				return nil, ctxErr
+			}
+			f.Err = err
+			continue
+		}
+		if details.Result != EResultOK {
+			f.Err = details.Result
+			continue
+		}
+		f.Size = details.FileSize
+		f.SHA1 = details.FileSHA
+		f.Err = verifyFile(path, f.Size, f.SHA1)
+	}
+	return r, nil
+}
+
+// verifyFile reports whether the file at path has the given size and hash.
+func verifyFile(path string, size uint64, hash [sha1.Size]byte) error {
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return ErrFileMissing
+		}
+		return err
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	if uint64(stat.Size()) != size {
+		return ErrFileModified
+	}
+
+	h := sha1.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return err
+	}
+	var sum [sha1.Size]byte
+	h.Sum(sum[:0])
+	if sum != hash {
+		return ErrFileModified
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"context"
+	"crypto/sha1"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestVerifyFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "data.pak")
+	content := []byte("game data")
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		t.Fatal(err)
+	}
+	sum := sha1.Sum(content)
+	other := sha1.Sum([]byte("game dat4"))
+
+	tests := []struct {
+		name string
+		path string
+		size uint64
+		hash [sha1.Size]byte
+		want error
+	}{
+		{"match", path, uint64(len(content)), sum, nil},
+		{"missing", filepath.Join(dir, "missing.pak"), uint64(len(content)), sum, ErrFileMissing},
+		{"size mismatch", path, uint64(len(content)) + 1, sum, ErrFileModified},
+		{"hash mismatch", path, uint64(len(content)), other, ErrFileModified},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := verifyFile(tt.path, tt.size, tt.hash); !errors.Is(err, tt.want) {
+				t.Errorf("verifyFile = %v; want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+// fakeFileDetailsApps returns a failed result for the first file and pending results for
+// the others.
+type fakeFileDetailsApps struct {
+	ISteamApps
+	calls []*CallResult[FileDetailsResult_t]
+}
+
+func (a *fakeFileDetailsApps) GetFileDetails(fileName string) *CallResult[FileDetailsResult_t] {
+	var r *CallResult[FileDetailsResult_t]
+	if len(a.calls) == 0 {
+		r = failedCallResult[FileDetailsResult_t](EResultFileNotFound)
+	} else {
+		r = newCallResult[FileDetailsResult_t](SteamAPICallbackHandle(len(a.calls)))
+	}
+	a.calls = append(a.calls, r)
+	return r
+}
+
+func TestVerifyFilesCancelled(t *testing.T) {
+	defer theDispatcher.reset()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	apps := &fakeFileDetailsApps{}
+	if _, err := VerifyFiles(ctx, apps, []string{"a", "b", "c"}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("VerifyFiles: got %v, want context.Canceled", err)
+	}
+
+	for _, r := range apps.calls[1:] {
+		if theDispatcher.cancelCallResult(r.Call()) {
+			t.Errorf("call %d still registered after VerifyFiles returned", r.Call())
+		}
+		select {
+		case <-r.Done():
+		default:
+			t.Errorf("call %d still pending after VerifyFiles returned", r.Call())
+			continue
+		}
+		if _, err := r.Await(context.Background()); !errors.Is(err, context.Canceled) {
+			t.Errorf("call %d: got %v, want context.Canceled", r.Call(), err)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import "fmt"
+
+// steamInterface describes the versions of a Steam interface this package can call.
+type steamInterface struct {
+	name     string
+	accessor flatAPI
+
+	// prefix is the symbol of the accessor without its version number.
+	prefix string
+
+	// versions lists the known versions, newest first. The newest one the library exports is bound.
+	versions []int
+
+	// methods lists the methods that are only available in some versions.
+	methods map[flatAPI]versionRange
+}
+
+// versionRange is the range of interface versions a method can be called with.
+// A zero bound is open.
+type versionRange struct {
+	since int
+	until int // exclusive
+}
+
+func (r versionRange) contains(version int) bool {
+	return version >= r.since && (r.until == 0 || version < r.until)
+}
+
+func (i *steamInterface) symbol(version int) string {
+	return fmt.Sprintf("%s%03d", i.prefix, version)
+}
+
+var steamInterfaces = []steamInterface{
+	{
+		name:     "ISteamApps",
+		accessor: flatAPI_SteamApps,
+		prefix:   "SteamAPI_SteamApps_v",
+		versions: []int{8},
+	},
+	{
+		name:     "ISteamInput",
+		accessor: flatAPI_SteamInput,
+		prefix:   "SteamAPI_SteamInput_v",
+		versions: []int{6},
+	},
+	{
+		name:     "ISteamRemoteStorage",
+		accessor: flatAPI_SteamRemoteStorage,
+		prefix:   "SteamAPI_SteamRemoteStorage_v",
+		versions: []int{16, 14},
+	},
+	{
+		name:     "ISteamUser",
+		accessor: flatAPI_SteamUser,
+		prefix:   "SteamAPI_SteamUser_v",
+		versions: []int{23, 22, 21},
+	},
+	{
+		name:     "ISteamUserStats",
+		accessor: flatAPI_SteamUserStats,
+		prefix:   "SteamAPI_SteamUserStats_v",
+		versions: []int{13, 12},
+		methods: map[flatAPI]versionRange{
+			// Stats are requested by Steam itself since version 13.
+			flatAPI_ISteamUserStats_RequestCurrentStats: {until: 13},
+		},
+	},
+	{
+		name:     "ISteamUtils",
+		accessor: flatAPI_SteamUtils,
+		prefix:   "SteamAPI_SteamUtils_v",
+		versions: []int{10, 9},
+		methods: map[flatAPI]versionRange{
+			flatAPI_ISteamUtils_IsSteamRunningOnSteamDeck: {since: 10},
+		},
+	},
+	{
+		name:     "ISteamNetworkingMessages",
+		accessor: flatAPI_SteamNetworkingMessages,
+		prefix:   "SteamAPI_SteamNetworkingMessages_SteamAPI_v",
+		versions: []int{2},
+	},
+	{
+		name:     "ISteamMatchmaking",
+		accessor: flatAPI_SteamMatchmaking,
+		prefix:   "SteamAPI_SteamMatchmaking_v",
+		versions: []int{9},
+	},
+}
+
+// InterfaceVersion loads the library if needed and returns the version of the Steam
+// interface iface, such as "ISteamUserStats", that this package calls. It returns 0 if the
+// library exports none of the versions this package knows.
+func InterfaceVersion(iface string) (int, error) {
+	t, err := loadedProcs()
+	if err != nil {
+		return 0, err
+	}
+	return t.interfaceVersion(iface)
+}
+
+// interfaceVersion returns the version of the Steam interface iface bound in t.
+func (t *procTable) interfaceVersion(iface string) (int, error) {
+	for _, i := range steamInterfaces {
+		if i.name == iface {
+			return t.versions[i.accessor], nil
+		}
+	}
+	return 0, fmt.Errorf("steamworks: unknown interface %s", iface)
+}
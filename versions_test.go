@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"errors"
+	"testing"
+)
+
+// fakeLookup returns a lookup that resolves every symbol but those in missing.
+func fakeLookup(missing ...string) func(name string) uintptr {
+	return func(name string) uintptr {
+		for _, m := range missing {
+			if name == m {
+				return 0
+			}
+		}
+		return 1
+	}
+}
+
+func TestBind(t *testing.T) {
+	tests := []struct {
+		name    string
+		missing []string
+		iface   string
+		want    int
+		symbol  string
+	}{
+		{
+			name:   "newest version",
+			iface:  "ISteamUserStats",
+			want:   13,
+			symbol: "SteamAPI_SteamUserStats_v013",
+		},
+		{
+			name:    "older version",
+			missing: []string{"SteamAPI_SteamUserStats_v013"},
+			iface:   "ISteamUserStats",
+			want:    12,
+			symbol:  "SteamAPI_SteamUserStats_v012",
+		},
+		{
+			name:    "oldest of three versions",
+			missing: []string{"SteamAPI_SteamUser_v023", "SteamAPI_SteamUser_v022"},
+			iface:   "ISteamUser",
+			want:    21,
+			symbol:  "SteamAPI_SteamUser_v021",
+		},
+		{
+			name:    "no known version",
+			missing: []string{"SteamAPI_SteamUtils_v010", "SteamAPI_SteamUtils_v009"},
+			iface:   "ISteamUtils",
+			want:    0,
+			symbol:  "SteamAPI_SteamUtils_v010",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := resolveProcs(fakeLookup(tt.missing...))
+			got, err := table.interfaceVersion(tt.iface)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("interfaceVersion(%q) = %d; want %d", tt.iface, got, tt.want)
+			}
+
+			var accessor flatAPI
+			for _, i := range steamInterfaces {
+				if i.name == tt.iface {
+					accessor = i.accessor
+				}
+			}
+			if table.names[accessor] != tt.symbol {
+				t.Errorf("accessor symbol = %q; want %q", table.names[accessor], tt.symbol)
+			}
+			_, err = table.proc(accessor)
+			if tt.want == 0 {
+				var serr *symbolError
+				if !errors.As(err, &serr) {
+					t.Errorf("proc(accessor) = %v; want a symbolError", err)
+				}
+				if !containsString(table.missing, tt.symbol) {
+					t.Errorf("missing = %v; want it to list %s", table.missing, tt.symbol)
+				}
+			} else if err != nil {
+				t.Errorf("proc(accessor): %v", err)
+			}
+		})
+	}
+}
+
+func TestBindRefusesMethods(t *testing.T) {
+	tests := []struct {
+		name    string
+		missing []string
+		method  flatAPI
+		refused bool
+	}{
+		{
+			name:    "RequestCurrentStats on v13",
+			method:  flatAPI_ISteamUserStats_RequestCurrentStats,
+			refused: true,
+		},
+		{
+			name:    "RequestCurrentStats on v12",
+			missing: []string{"SteamAPI_SteamUserStats_v013"},
+			method:  flatAPI_ISteamUserStats_RequestCurrentStats,
+		},
+		{
+			name:   "IsSteamRunningOnSteamDeck on v10",
+			method: flatAPI_ISteamUtils_IsSteamRunningOnSteamDeck,
+		},
+		{
+			name:    "IsSteamRunningOnSteamDeck on v9",
+			missing: []string{"SteamAPI_SteamUtils_v010"},
+			method:  flatAPI_ISteamUtils_IsSteamRunningOnSteamDeck,
+			refused: true,
+		},
+		{
+			name:   "unversioned method",
+			method: flatAPI_ISteamUserStats_StoreStats,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := resolveProcs(fakeLookup(tt.missing...))
+			_, err := table.proc(tt.method)
+			if got := errors.Is(err, ErrUnsupportedVersion); got != tt.refused {
+				t.Errorf("proc(%s) = %v; ErrUnsupportedVersion %v, want %v", flatAPINames[tt.method], err, got, tt.refused)
+			}
+			if tt.refused && containsString(table.missing, flatAPINames[tt.method]) {
+				t.Errorf("refused method %s listed as missing", flatAPINames[tt.method])
+			}
+		})
+	}
+}
+
+func TestInterfaceVersionUnknown(t *testing.T) {
+	table := resolveProcs(fakeLookup())
+	if _, err := table.interfaceVersion("ISteamNothing"); err == nil {
+		t.Error("interfaceVersion of an unknown interface succeeded")
+	}
+}
@@ -0,0 +1,469 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import "runtime"
+
+// The *WithError accessors and interfaces mirror the plain ones, but report failures
+// such as a missing export in the loaded library or an uninitialized interface as
+// errors instead of panicking.
+
+// access calls the interface accessor f. It reports an error if the Steam API is not
+// available or f panics.
+func access(f func()) error {
+	if err := available(); err != nil {
+		return err
+	}
+	return protect(f)
+}
+
+// protect runs f and converts a panic raised by it into an error. Runtime errors, such
+// as a nil dereference, are bugs rather than failures of the Steam API, so they are not
+// recovered.
+func protect(f func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if rerr, ok := r.(runtime.Error); ok {
+				panic(rerr)
+			}
+			err = panicError(r)
+		}
+	}()
+	f()
+	return nil
+}
+
+type ISteamAppsWithError interface {
+	BIsSubscribed() (bool, error)
+	BIsSubscribedApp(appID AppId_t) (bool, error)
+	BIsLowViolence() (bool, error)
+	BIsVACBanned() (bool, error)
+	BIsSubscribedFromFreeWeekend() (bool, error)
+	BIsSubscribedFromFamilySharing() (bool, error)
+	GetAppOwner() (CSteamID, error)
+	GetEarliestPurchaseUnixTime(appID AppId_t) (uint32, error)
+	GetAppBuildId() (int32, error)
+	GetCurrentBetaName() (name string, ok bool, err error)
+	GetDLCCount() (int32, error)
+	BGetDLCDataByIndex(iDLC int32) (appID AppId_t, available bool, name string, success bool, err error)
+	BIsDlcInstalled(appID AppId_t) (bool, error)
+	InstallDLC(appID AppId_t) error
+	UninstallDLC(appID AppId_t) error
+	GetDlcDownloadProgress(appID AppId_t) (bytesDownloaded, bytesTotal uint64, downloading bool, err error)
+	BIsAppInstalled(appID AppId_t) (bool, error)
+	GetInstalledDepots(appID AppId_t) ([]DepotId_t, error)
+	GetFileDetails(fileName string) (*CallResult[FileDetailsResult_t], error)
+	MarkContentCorrupt(missingFilesOnly bool) (bool, error)
+	GetAppInstallDir(appID AppId_t) (string, error)
+	GetCurrentGameLanguage() (string, error)
+	GetAvailableGameLanguages() (string, error)
+	GetLaunchQueryParam(key string) (string, error)
+	GetLaunchCommandLine() (string, error)
+}
+
+type ISteamInputWithError interface {
+	GetConnectedControllers() ([]InputHandle_t, error)
+	GetInputTypeForHandle(inputHandle InputHandle_t) (ESteamInputType, error)
+	Init(bExplicitlyCallRunFrame bool) (bool, error)
+	RunFrame() error
+}
+
+type ISteamMatchmakingWithError interface {
+	CreateLobby(eLobbyType ELobbyType, cMaxMembers int32) (*CallResult[LobbyCreated_t], error)
+	RequestLobbyList() (*CallResult[LobbyMatchList_t], error)
+	GetLobbyByIndex(iLobby int32) (CSteamID, error)
+	LeaveLobby(steamIDLobby CSteamID) error
+}
+
+type ISteamNetworkingMessagesWithError interface {
+	SendMessageToUser(identity SteamNetworkingIdentity, data []byte, sendFlags int32, channel int32) (EResult, error)
+	ReceiveMessagesOnChannel(localChannel int32, maxMessages int32) ([]NetworkingMessage, error)
+	BorrowMessagesOnChannel(localChannel int32, maxMessages int32, pool *MessagePool) ([]NetworkingMessage, error)
+	AcceptSessionWithUser(identityRemote SteamNetworkingIdentity) (bool, error)
+	CloseSessionWithUser(identityRemote SteamNetworkingIdentity) (bool, error)
+	CloseChannelWithUser(identityRemote SteamNetworkingIdentity, nLocalChannel int32) (bool, error)
+	GetSessionConnectionInfo(identityRemote SteamNetworkingIdentity) (ESteamNetworkingConnectionState, SteamNetConnectionInfo_t, SteamNetConnectionRealTimeStatus_t, error)
+}
+
+type ISteamRemoteStorageWithError interface {
+	FileWrite(file string, data []byte) (bool, error)
+	FileRead(file string, data []byte) (int32, error)
+	FileDelete(file string) (bool, error)
+	GetFileSize(file string) (int32, error)
+}
+
+type ISteamUserWithError interface {
+	GetSteamID() (CSteamID, error)
+}
+
+type ISteamUserStatsWithError interface {
+	RequestCurrentStats() (bool, error)
+	GetAchievement(name string) (achieved, success bool, err error)
+	SetAchievement(name string) (bool, error)
+	ClearAchievement(name string) (bool, error)
+	StoreStats() (bool, error)
+}
+
+type ISteamUtilsWithError interface {
+	IsSteamRunningOnSteamDeck() (bool, error)
+	GetAPICallFailureReason(call SteamAPICallbackHandle) (ESteamAPICallFailure, error)
+	GetSteamUILanguage() (string, error)
+}
+
+func SteamAppsWithError() (ISteamAppsWithError, error) {
+	var s ISteamApps
+	if err := access(func() { s = SteamApps() }); err != nil {
+		return nil, err
+	}
+	return steamAppsWithError{s}, nil
+}
+
+type steamAppsWithError struct {
+	s ISteamApps
+}
+
+func (s steamAppsWithError) BIsSubscribed() (ok bool, err error) {
+	err = protect(func() { ok = s.s.BIsSubscribed() })
+	return
+}
+
+func (s steamAppsWithError) BIsSubscribedApp(appID AppId_t) (ok bool, err error) {
+	err = protect(func() { ok = s.s.BIsSubscribedApp(appID) })
+	return
+}
+
+func (s steamAppsWithError) BIsLowViolence() (ok bool, err error) {
+	err = protect(func() { ok = s.s.BIsLowViolence() })
+	return
+}
+
+func (s steamAppsWithError) BIsVACBanned() (ok bool, err error) {
+	err = protect(func() { ok = s.s.BIsVACBanned() })
+	return
+}
+
+func (s steamAppsWithError) BIsSubscribedFromFreeWeekend() (ok bool, err error) {
+	err = protect(func() { ok = s.s.BIsSubscribedFromFreeWeekend() })
+	return
+}
+
+func (s steamAppsWithError) BIsSubscribedFromFamilySharing() (ok bool, err error) {
+	err = protect(func() { ok = s.s.BIsSubscribedFromFamilySharing() })
+	return
+}
+
+func (s steamAppsWithError) GetAppOwner() (id CSteamID, err error) {
+	err = protect(func() { id = s.s.GetAppOwner() })
+	return
+}
+
+func (s steamAppsWithError) GetEarliestPurchaseUnixTime(appID AppId_t) (t uint32, err error) {
+	err = protect(func() { t = s.s.GetEarliestPurchaseUnixTime(appID) })
+	return
+}
+
+func (s steamAppsWithError) GetAppBuildId() (id int32, err error) {
+	err = protect(func() { id = s.s.GetAppBuildId() })
+	return
+}
+
+func (s steamAppsWithError) GetCurrentBetaName() (name string, ok bool, err error) {
+	err = protect(func() { name, ok = s.s.GetCurrentBetaName() })
+	return
+}
+
+func (s steamAppsWithError) GetDLCCount() (n int32, err error) {
+	err = protect(func() { n = s.s.GetDLCCount() })
+	return
+}
+
+func (s steamAppsWithError) BGetDLCDataByIndex(iDLC int32) (appID AppId_t, available bool, name string, success bool, err error) {
+	err = protect(func() { appID, available, name, success = s.s.BGetDLCDataByIndex(iDLC) })
+	return
+}
+
+func (s steamAppsWithError) BIsDlcInstalled(appID AppId_t) (ok bool, err error) {
+	err = protect(func() { ok = s.s.BIsDlcInstalled(appID) })
+	return
+}
+
+func (s steamAppsWithError) InstallDLC(appID AppId_t) error {
+	return protect(func() { s.s.InstallDLC(appID) })
+}
+
+func (s steamAppsWithError) UninstallDLC(appID AppId_t) error {
+	return protect(func() { s.s.UninstallDLC(appID) })
+}
+
+func (s steamAppsWithError) GetDlcDownloadProgress(appID AppId_t) (bytesDownloaded, bytesTotal uint64, downloading bool, err error) {
+	err = protect(func() { bytesDownloaded, bytesTotal, downloading = s.s.GetDlcDownloadProgress(appID) })
+	return
+}
+
+func (s steamAppsWithError) BIsAppInstalled(appID AppId_t) (ok bool, err error) {
+	err = protect(func() { ok = s.s.BIsAppInstalled(appID) })
+	return
+}
+
+func (s steamAppsWithError) GetInstalledDepots(appID AppId_t) (depots []DepotId_t, err error) {
+	err = protect(func() { depots = s.s.GetInstalledDepots(appID) })
+	return
+}
+
+func (s steamAppsWithError) GetFileDetails(fileName string) (r *CallResult[FileDetailsResult_t], err error) {
+	err = protect(func() { r = s.s.GetFileDetails(fileName) })
+	return
+}
+
+func (s steamAppsWithError) MarkContentCorrupt(missingFilesOnly bool) (ok bool, err error) {
+	err = protect(func() { ok = s.s.MarkContentCorrupt(missingFilesOnly) })
+	return
+}
+
+func (s steamAppsWithError) GetAppInstallDir(appID AppId_t) (dir string, err error) {
+	err = protect(func() { dir = s.s.GetAppInstallDir(appID) })
+	return
+}
+
+func (s steamAppsWithError) GetCurrentGameLanguage() (lang string, err error) {
+	err = protect(func() { lang = s.s.GetCurrentGameLanguage() })
+	return
+}
+
+func (s steamAppsWithError) GetAvailableGameLanguages() (langs string, err error) {
+	err = protect(func() { langs = s.s.GetAvailableGameLanguages() })
+	return
+}
+
+func (s steamAppsWithError) GetLaunchQueryParam(key string) (value string, err error) {
+	err = protect(func() { value = s.s.GetLaunchQueryParam(key) })
+	return
+}
+
+func (s steamAppsWithError) GetLaunchCommandLine() (cmd string, err error) {
+	err = protect(func() { cmd = s.s.GetLaunchCommandLine() })
+	return
+}
+
+func SteamInputWithError() (ISteamInputWithError, error) {
+	var s ISteamInput
+	if err := access(func() { s = SteamInput() }); err != nil {
+		return nil, err
+	}
+	return steamInputWithError{s}, nil
+}
+
+type steamInputWithError struct {
+	s ISteamInput
+}
+
+func (s steamInputWithError) GetConnectedControllers() (handles []InputHandle_t, err error) {
+	err = protect(func() { handles = s.s.GetConnectedControllers() })
+	return
+}
+
+func (s steamInputWithError) GetInputTypeForHandle(inputHandle InputHandle_t) (t ESteamInputType, err error) {
+	err = protect(func() { t = s.s.GetInputTypeForHandle(inputHandle) })
+	return
+}
+
+func (s steamInputWithError) Init(bExplicitlyCallRunFrame bool) (ok bool, err error) {
+	err = protect(func() { ok = s.s.Init(bExplicitlyCallRunFrame) })
+	return
+}
+
+func (s steamInputWithError) RunFrame() error {
+	return protect(s.s.RunFrame)
+}
+
+func SteamMatchmakingWithError() (ISteamMatchmakingWithError, error) {
+	var s ISteamMatchmaking
+	if err := access(func() { s = SteamMatchmaking() }); err != nil {
+		return nil, err
+	}
+	return steamMatchmakingWithError{s}, nil
+}
+
+type steamMatchmakingWithError struct {
+	s ISteamMatchmaking
+}
+
+func (s steamMatchmakingWithError) CreateLobby(eLobbyType ELobbyType, cMaxMembers int32) (r *CallResult[LobbyCreated_t], err error) {
+	err = protect(func() { r = s.s.CreateLobby(eLobbyType, cMaxMembers) })
+	return
+}
+
+func (s steamMatchmakingWithError) RequestLobbyList() (r *CallResult[LobbyMatchList_t], err error) {
+	err = protect(func() { r = s.s.RequestLobbyList() })
+	return
+}
+
+func (s steamMatchmakingWithError) GetLobbyByIndex(iLobby int32) (id CSteamID, err error) {
+	err = protect(func() { id = s.s.GetLobbyByIndex(iLobby) })
+	return
+}
+
+func (s steamMatchmakingWithError) LeaveLobby(steamIDLobby CSteamID) error {
+	return protect(func() { s.s.LeaveLobby(steamIDLobby) })
+}
+
+func SteamNetworkingMessagesWithError() (ISteamNetworkingMessagesWithError, error) {
+	var s ISteamNetworkingMessages
+	if err := access(func() { s = SteamNetworkingMessages() }); err != nil {
+		return nil, err
+	}
+	return steamNetworkingMessagesWithError{s}, nil
+}
+
+type steamNetworkingMessagesWithError struct {
+	s ISteamNetworkingMessages
+}
+
+func (s steamNetworkingMessagesWithError) SendMessageToUser(identity SteamNetworkingIdentity, data []byte, sendFlags int32, channel int32) (result EResult, err error) {
+	err = protect(func() { result = s.s.SendMessageToUser(identity, data, sendFlags, channel) })
+	return
+}
+
+func (s steamNetworkingMessagesWithError) ReceiveMessagesOnChannel(localChannel int32, maxMessages int32) (msgs []NetworkingMessage, err error) {
+	err = protect(func() { msgs = s.s.ReceiveMessagesOnChannel(localChannel, maxMessages) })
+	return
+}
+
+func (s steamNetworkingMessagesWithError) BorrowMessagesOnChannel(localChannel int32, maxMessages int32, pool *MessagePool) (msgs []NetworkingMessage, err error) {
+	err = protect(func() { msgs = s.s.BorrowMessagesOnChannel(localChannel, maxMessages, pool) })
+	return
+}
+
+func (s steamNetworkingMessagesWithError) AcceptSessionWithUser(identityRemote SteamNetworkingIdentity) (ok bool, err error) {
+	err = protect(func() { ok = s.s.AcceptSessionWithUser(identityRemote) })
+	return
+}
+
+func (s steamNetworkingMessagesWithError) CloseSessionWithUser(identityRemote SteamNetworkingIdentity) (ok bool, err error) {
+	err = protect(func() { ok = s.s.CloseSessionWithUser(identityRemote) })
+	return
+}
+
+func (s steamNetworkingMessagesWithError) CloseChannelWithUser(identityRemote SteamNetworkingIdentity, nLocalChannel int32) (ok bool, err error) {
+	err = protect(func() { ok = s.s.CloseChannelWithUser(identityRemote, nLocalChannel) })
+	return
+}
+
+func (s steamNetworkingMessagesWithError) GetSessionConnectionInfo(identityRemote SteamNetworkingIdentity) (state ESteamNetworkingConnectionState, info SteamNetConnectionInfo_t, status SteamNetConnectionRealTimeStatus_t, err error) {
+	err = protect(func() { state, info, status = s.s.GetSessionConnectionInfo(identityRemote) })
+	return
+}
+
+func SteamRemoteStorageWithError() (ISteamRemoteStorageWithError, error) {
+	var s ISteamRemoteStorage
+	if err := access(func() { s = SteamRemoteStorage() }); err != nil {
+		return nil, err
+	}
+	return steamRemoteStorageWithError{s}, nil
+}
+
+type steamRemoteStorageWithError struct {
+	s ISteamRemoteStorage
+}
+
+func (s steamRemoteStorageWithError) FileWrite(file string, data []byte) (ok bool, err error) {
+	err = protect(func() { ok = s.s.FileWrite(file, data) })
+	return
+}
+
+func (s steamRemoteStorageWithError) FileRead(file string, data []byte) (n int32, err error) {
+	err = protect(func() { n = s.s.FileRead(file, data) })
+	return
+}
+
+func (s steamRemoteStorageWithError) FileDelete(file string) (ok bool, err error) {
+	err = protect(func() { ok = s.s.FileDelete(file) })
+	return
+}
+
+func (s steamRemoteStorageWithError) GetFileSize(file string) (size int32, err error) {
+	err = protect(func() { size = s.s.GetFileSize(file) })
+	return
+}
+
+func SteamUserWithError() (ISteamUserWithError, error) {
+	var s ISteamUser
+	if err := access(func() { s = SteamUser() }); err != nil {
+		return nil, err
+	}
+	return steamUserWithError{s}, nil
+}
+
+type steamUserWithError struct {
+	s ISteamUser
+}
+
+func (s steamUserWithError) GetSteamID() (id CSteamID, err error) {
+	err = protect(func() { id = s.s.GetSteamID() })
+	return
+}
+
+func SteamUserStatsWithError() (ISteamUserStatsWithError, error) {
+	var s ISteamUserStats
+	if err := access(func() { s = SteamUserStats() }); err != nil {
+		return nil, err
+	}
+	return steamUserStatsWithError{s}, nil
+}
+
+type steamUserStatsWithError struct {
+	s ISteamUserStats
+}
+
+func (s steamUserStatsWithError) RequestCurrentStats() (ok bool, err error) {
+	err = protect(func() { ok = s.s.RequestCurrentStats() })
+	return
+}
+
+func (s steamUserStatsWithError) GetAchievement(name string) (achieved, success bool, err error) {
+	err = protect(func() { achieved, success = s.s.GetAchievement(name) })
+	return
+}
+
+func (s steamUserStatsWithError) SetAchievement(name string) (ok bool, err error) {
+	err = protect(func() { ok = s.s.SetAchievement(name) })
+	return
+}
+
+func (s steamUserStatsWithError) ClearAchievement(name string) (ok bool, err error) {
+	err = protect(func() { ok = s.s.ClearAchievement(name) })
+	return
+}
+
+func (s steamUserStatsWithError) StoreStats() (ok bool, err error) {
+	err = protect(func() { ok = s.s.StoreStats() })
+	return
+}
+
+func SteamUtilsWithError() (ISteamUtilsWithError, error) {
+	var s ISteamUtils
+	if err := access(func() { s = SteamUtils() }); err != nil {
+		return nil, err
+	}
+	return steamUtilsWithError{s}, nil
+}
+
+type steamUtilsWithError struct {
+	s ISteamUtils
+}
+
+func (s steamUtilsWithError) IsSteamRunningOnSteamDeck() (ok bool, err error) {
+	err = protect(func() { ok = s.s.IsSteamRunningOnSteamDeck() })
+	return
+}
+
+func (s steamUtilsWithError) GetAPICallFailureReason(call SteamAPICallbackHandle) (reason ESteamAPICallFailure, err error) {
+	err = protect(func() { reason = s.s.GetAPICallFailureReason(call) })
+	return
+}
+
+func (s steamUtilsWithError) GetSteamUILanguage() (lang string, err error) {
+	err = protect(func() { lang = s.s.GetSteamUILanguage() })
+	return
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+)
+
+func TestProtect(t *testing.T) {
+	if err := protect(func() {}); err != nil {
+		t.Errorf("protect without a panic: %v", err)
+	}
+
+	want := &symbolError{name: "SteamAPI_ISteamApps_BIsSubscribed"}
+	if err := protect(func() { panic(want) }); err != want {
+		t.Errorf("protect: got %v, want %v", err, want)
+	}
+
+	if err := protect(func() { panic("no library") }); err == nil || err.Error() != "steamworks: no library" {
+		t.Errorf("protect with a string panic: got %v", err)
+	}
+}
+
+func TestProtectRuntimeError(t *testing.T) {
+	defer func() {
+		r := recover()
+		var rerr runtime.Error
+		if err, ok := r.(error); !ok || !errors.As(err, &rerr) {
+			t.Errorf("recovered %v, want a runtime.Error", r)
+		}
+	}()
+
+	var p *procTable
+	err := protect(func() {
+		_ = p.names
+	})
+	t.Errorf("protect recovered a runtime error as %v", err)
+}